@@ -0,0 +1,518 @@
+package imgui
+
+// #include "wrapper/Accessibility.h"
+import "C"
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+)
+
+// Accessibility tree
+// While enabled, the current context builds a tree of the windows and widgets submitted during a frame,
+// as they pass through the wrapper functions of this package. The tree is started with NewFrame(), and
+// completed with Render() or EndFrame(); LastAccessibilityTree() returns the tree of the last completed frame.
+// The tree can be handed to assistive technology, or be used to inspect the UI in tests.
+//
+// Items submitted directly through C code, such as the contents of ShowDemoWindow(), are not part of the tree.
+// The tree is disabled by default, and costs nothing while disabled.
+
+// AccessibilityTreeVersion is the version of the structure of AccessibilityTree.
+// It is increased with any change that is not backwards compatible for readers of the JSON form.
+const AccessibilityTreeVersion = 1
+
+// AccessibilityRole describes the purpose of a node of the accessibility tree.
+type AccessibilityRole string
+
+// This is a list of the roles of accessibility nodes.
+const (
+	AccessibilityRoleWindow       AccessibilityRole = "window"
+	AccessibilityRoleChildWindow  AccessibilityRole = "childwindow"
+	AccessibilityRolePopup        AccessibilityRole = "popup"
+	AccessibilityRoleTooltip      AccessibilityRole = "tooltip"
+	AccessibilityRoleText         AccessibilityRole = "text"
+	AccessibilityRoleButton       AccessibilityRole = "button"
+	AccessibilityRoleCheckbox     AccessibilityRole = "checkbox"
+	AccessibilityRoleRadioButton  AccessibilityRole = "radiobutton"
+	AccessibilityRoleSlider       AccessibilityRole = "slider"
+	AccessibilityRoleTextBox      AccessibilityRole = "textbox"
+	AccessibilityRoleSpinButton   AccessibilityRole = "spinbutton"
+	AccessibilityRoleComboBox     AccessibilityRole = "combobox"
+	AccessibilityRoleListBox      AccessibilityRole = "listbox"
+	AccessibilityRoleSelectable   AccessibilityRole = "selectable"
+	AccessibilityRoleTreeNode     AccessibilityRole = "treenode"
+	AccessibilityRoleProgressBar  AccessibilityRole = "progressbar"
+	AccessibilityRoleImage        AccessibilityRole = "image"
+	AccessibilityRolePlot         AccessibilityRole = "plot"
+	AccessibilityRoleMenuBar      AccessibilityRole = "menubar"
+	AccessibilityRoleMenu         AccessibilityRole = "menu"
+	AccessibilityRoleMenuItem     AccessibilityRole = "menuitem"
+	AccessibilityRoleTabBar       AccessibilityRole = "tabbar"
+	AccessibilityRoleTab          AccessibilityRole = "tab"
+	AccessibilityRoleTable        AccessibilityRole = "table"
+	AccessibilityRoleRow          AccessibilityRole = "row"
+	AccessibilityRoleCell         AccessibilityRole = "cell"
+	AccessibilityRoleColumnHeader AccessibilityRole = "columnheader"
+)
+
+// AccessibilityBounds is the rectangle of a node, in screen space.
+type AccessibilityBounds struct {
+	X      float32 `json:"x"`
+	Y      float32 `json:"y"`
+	Width  float32 `json:"width"`
+	Height float32 `json:"height"`
+}
+
+// Min returns the upper-left corner of the bounds.
+func (bounds AccessibilityBounds) Min() Vec2 {
+	return Vec2{X: bounds.X, Y: bounds.Y}
+}
+
+// Max returns the lower-right corner of the bounds.
+func (bounds AccessibilityBounds) Max() Vec2 {
+	return Vec2{X: bounds.X + bounds.Width, Y: bounds.Y + bounds.Height}
+}
+
+func newAccessibilityBounds(min, max Vec2) AccessibilityBounds {
+	return AccessibilityBounds{X: min.X, Y: min.Y, Width: max.X - min.X, Height: max.Y - min.Y}
+}
+
+func (bounds AccessibilityBounds) isEmpty() bool {
+	return (bounds.Width <= 0) || (bounds.Height <= 0)
+}
+
+func (bounds AccessibilityBounds) union(other AccessibilityBounds) AccessibilityBounds {
+	if bounds.isEmpty() {
+		return other
+	}
+	if other.isEmpty() {
+		return bounds
+	}
+	boundsMin, boundsMax := bounds.Min(), bounds.Max()
+	otherMin, otherMax := other.Min(), other.Max()
+	return newAccessibilityBounds(
+		Vec2{X: min(boundsMin.X, otherMin.X), Y: min(boundsMin.Y, otherMin.Y)},
+		Vec2{X: max(boundsMax.X, otherMax.X), Y: max(boundsMax.Y, otherMax.Y)})
+}
+
+// AccessibilityNode is a window, a widget or a structural element, such as a table row, of the accessibility tree.
+type AccessibilityNode struct {
+	// Role is the purpose of the node.
+	Role AccessibilityRole `json:"role"`
+	// ID is the ID of the item. It is zero for items without an ID, such as text or table cells.
+	ID ID `json:"id,omitempty"`
+	// Label is the visible part of the label of the item, without any "##" suffix.
+	Label string `json:"label"`
+	// Value is the current value of the item as text, such as the value of a slider or the contents of a text field.
+	// It is empty for items without a value, and for password fields.
+	Value string `json:"value,omitempty"`
+	// Checked is set for items that can be checked, such as checkboxes and radio buttons.
+	Checked *bool `json:"checked,omitempty"`
+	// Selected is set for items that can be selected, such as selectables and tabs.
+	Selected *bool `json:"selected,omitempty"`
+	// Expanded is set for items that can be opened, such as tree nodes, combo boxes and menus.
+	Expanded *bool `json:"expanded,omitempty"`
+	// Enabled is false for items within a disabled block, see BeginDisabled().
+	Enabled bool `json:"enabled"`
+	// Bounds is the rectangle of the item, in screen space.
+	Bounds AccessibilityBounds `json:"bounds"`
+	// Window is the name of the window the node was submitted in. For windows, it is their own name.
+	// Names of windows created by ImGui, such as those of popups and tooltips, are generated.
+	Window string `json:"window"`
+	// Children are the nodes submitted within this node, in submission order.
+	Children []*AccessibilityNode `json:"children,omitempty"`
+}
+
+// Find returns the first node, in depth-first order starting with this node, with the given role and label.
+// It returns nil if there is no such node.
+func (node *AccessibilityNode) Find(role AccessibilityRole, label string) *AccessibilityNode {
+	if (node.Role == role) && (node.Label == label) {
+		return node
+	}
+	for _, child := range node.Children {
+		if found := child.Find(role, label); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// AccessibilityTree is the accessibility tree of a frame. Windows, popups and tooltips are at the top level,
+// in the order they were begun; child windows are nested within their parent.
+//
+// The JSON form, as produced by encoding/json, is stable within the same Version.
+type AccessibilityTree struct {
+	// Version is the AccessibilityTreeVersion of the structure.
+	Version int `json:"version"`
+	// Windows are the top level nodes.
+	Windows []*AccessibilityNode `json:"windows"`
+}
+
+// Find returns the first node with the given role and label, in depth-first order.
+// It returns nil if there is no such node.
+func (tree AccessibilityTree) Find(role AccessibilityRole, label string) *AccessibilityNode {
+	for _, window := range tree.Windows {
+		if found := window.Find(role, label); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// accessibilityBuilders is the number of contexts with an enabled accessibility tree.
+// While it is zero, the wrapper functions skip the lookup of the context state.
+var accessibilityBuilders atomic.Int32
+
+// accessibilityBoundsMode determines how the bounds of a container are completed when it ends.
+type accessibilityBoundsMode int
+
+const (
+	accessibilityBoundsFixed accessibilityBoundsMode = iota
+	accessibilityBoundsFromItem
+	accessibilityBoundsFromChildren
+)
+
+type accessibilityContainer struct {
+	node   *AccessibilityNode
+	bounds accessibilityBoundsMode
+	window bool
+	// tableRow is the index of the row within its table, for rows.
+	tableRow int
+}
+
+// accessibilityBuilder collects the accessibility tree of a context.
+// It is only used by the goroutine that runs the frames of the context.
+type accessibilityBuilder struct {
+	enabled bool
+	inFrame bool
+
+	windows    []*AccessibilityNode
+	containers []accessibilityContainer
+	last       AccessibilityTree
+}
+
+// SetAccessibilityEnabled enables or disables the accessibility tree of the current context.
+// Changes take effect with the next call to NewFrame().
+func SetAccessibilityEnabled(enabled bool) {
+	state := currentState()
+	if state == detachedState {
+		return
+	}
+	state.accessibility.setEnabled(enabled)
+}
+
+// IsAccessibilityEnabled returns true if the accessibility tree of the current context is enabled.
+func IsAccessibilityEnabled() bool {
+	return currentState().accessibility.enabled
+}
+
+// LastAccessibilityTree returns the accessibility tree of the last completed frame of the current context.
+// The tree is empty if the accessibility tree is disabled, or no frame was completed yet.
+func LastAccessibilityTree() AccessibilityTree {
+	tree := currentState().accessibility.last
+	tree.Version = AccessibilityTreeVersion
+	if tree.Windows == nil {
+		tree.Windows = []*AccessibilityNode{}
+	}
+	return tree
+}
+
+func (builder *accessibilityBuilder) setEnabled(enabled bool) {
+	if builder.enabled == enabled {
+		return
+	}
+	builder.enabled = enabled
+	builder.inFrame = false
+	builder.windows = nil
+	builder.containers = nil
+	builder.last = AccessibilityTree{}
+	if enabled {
+		accessibilityBuilders.Add(1)
+	} else {
+		accessibilityBuilders.Add(-1)
+	}
+}
+
+// currentAccessibility returns the builder of the current context, if it is collecting a frame.
+func currentAccessibility() *accessibilityBuilder {
+	if accessibilityBuilders.Load() == 0 {
+		return nil
+	}
+	builder := &currentState().accessibility
+	if !builder.enabled || !builder.inFrame {
+		return nil
+	}
+	return builder
+}
+
+func accessibilityNewFrame() {
+	if accessibilityBuilders.Load() == 0 {
+		return
+	}
+	builder := &currentState().accessibility
+	if !builder.enabled {
+		return
+	}
+	builder.inFrame = true
+	builder.windows = nil
+	builder.containers = nil
+}
+
+func accessibilityEndFrame() {
+	builder := currentAccessibility()
+	if builder == nil {
+		return
+	}
+	for len(builder.containers) > 0 {
+		builder.popTop()
+	}
+	builder.last = AccessibilityTree{Version: AccessibilityTreeVersion, Windows: builder.windows}
+	builder.inFrame = false
+	builder.windows = nil
+}
+
+func newAccessibilityNode(role AccessibilityRole, label string, element *C.IggAccessibleElement) *AccessibilityNode {
+	return &AccessibilityNode{
+		Role:    role,
+		ID:      ID(element.ID),
+		Label:   displayLabel(label),
+		Enabled: element.Disabled == 0,
+		Bounds:  elementBounds(element),
+	}
+}
+
+func elementBounds(element *C.IggAccessibleElement) AccessibilityBounds {
+	return newAccessibilityBounds(
+		Vec2{X: float32(element.Min.x), Y: float32(element.Min.y)},
+		Vec2{X: float32(element.Max.x), Y: float32(element.Max.y)})
+}
+
+// window returns the name of the innermost window.
+func (builder *accessibilityBuilder) window() string {
+	for i := len(builder.containers) - 1; i >= 0; i-- {
+		if builder.containers[i].window {
+			return builder.containers[i].node.Window
+		}
+	}
+	return ""
+}
+
+// add appends the node to the innermost container. Nodes outside of any container are dropped,
+// as ImGui does not allow widgets outside of windows.
+func (builder *accessibilityBuilder) add(node *AccessibilityNode) *AccessibilityNode {
+	if len(builder.containers) == 0 {
+		return node
+	}
+	node.Window = builder.window()
+	parent := builder.containers[len(builder.containers)-1].node
+	parent.Children = append(parent.Children, node)
+	return node
+}
+
+func (builder *accessibilityBuilder) push(node *AccessibilityNode, bounds accessibilityBoundsMode) {
+	builder.containers = append(builder.containers, accessibilityContainer{node: node, bounds: bounds})
+}
+
+// pushWindow starts a node for the current window. Child windows are nested within their parent,
+// all other windows are top level nodes.
+func (builder *accessibilityBuilder) pushWindow(role AccessibilityRole, label string) {
+	var element C.IggAccessibleElement
+	C.iggAccessibleCurrentWindow(&element)
+	node := newAccessibilityNode(role, label, &element)
+	container := accessibilityContainer{node: node, bounds: accessibilityBoundsFixed, window: true}
+	if role == AccessibilityRoleChildWindow {
+		builder.add(node)
+		container.bounds = accessibilityBoundsFromItem
+	} else {
+		builder.windows = append(builder.windows, node)
+	}
+	node.Window = C.GoString(element.Name)
+	builder.containers = append(builder.containers, container)
+}
+
+// pop ends the innermost container with one of the given roles, and any containers within it.
+// Calls without a matching container are ignored.
+func (builder *accessibilityBuilder) pop(roles ...AccessibilityRole) {
+	if builder.popWithin(roles...) {
+		builder.popTop()
+	}
+}
+
+// popWithin ends the containers within the innermost container with one of the given roles.
+// It returns false if there is no such container.
+func (builder *accessibilityBuilder) popWithin(roles ...AccessibilityRole) bool {
+	for i := len(builder.containers) - 1; i >= 0; i-- {
+		if slices.Contains(roles, builder.containers[i].node.Role) {
+			for len(builder.containers) > i+1 {
+				builder.popTop()
+			}
+			return true
+		}
+	}
+	return false
+}
+
+func (builder *accessibilityBuilder) popTop() {
+	container := builder.containers[len(builder.containers)-1]
+	builder.containers = builder.containers[:len(builder.containers)-1]
+	switch container.bounds {
+	case accessibilityBoundsFromItem:
+		var element C.IggAccessibleElement
+		C.iggAccessibleLastItem(&element)
+		container.node.Bounds = elementBounds(&element)
+	case accessibilityBoundsFromChildren:
+		for _, child := range container.node.Children {
+			container.node.Bounds = container.node.Bounds.union(child.Bounds)
+		}
+	}
+}
+
+// top returns the innermost container, if it has one of the given roles.
+func (builder *accessibilityBuilder) top(roles ...AccessibilityRole) *AccessibilityNode {
+	if len(builder.containers) == 0 {
+		return nil
+	}
+	node := builder.containers[len(builder.containers)-1].node
+	if !slices.Contains(roles, node.Role) {
+		return nil
+	}
+	return node
+}
+
+// inTableRow returns true if the innermost container is the row of the given index.
+func (builder *accessibilityBuilder) inTableRow(row int) bool {
+	return (builder.top(AccessibilityRoleRow) != nil) && (builder.containers[len(builder.containers)-1].tableRow == row)
+}
+
+// accessibleItem adds a node for the last submitted item, and returns it.
+// It returns nil if the accessibility tree is not collected.
+func accessibleItem(role AccessibilityRole, label string) *AccessibilityNode {
+	builder := currentAccessibility()
+	if builder == nil {
+		return nil
+	}
+	var element C.IggAccessibleElement
+	C.iggAccessibleLastItem(&element)
+	return builder.add(newAccessibilityNode(role, label, &element))
+}
+
+// accessibleContainer adds a node for the last submitted item, and makes it the container of the following items
+// if open is true. It returns nil if the accessibility tree is not collected.
+func accessibleContainer(role AccessibilityRole, label string, open bool) *AccessibilityNode {
+	node := accessibleItem(role, label)
+	if (node != nil) && open {
+		currentAccessibility().push(node, accessibilityBoundsFixed)
+	}
+	return node
+}
+
+// accessibleScope starts a container without an item of its own, such as a menu bar or a table.
+// Its bounds are determined when it ends.
+func accessibleScope(role AccessibilityRole, label string, bounds accessibilityBoundsMode) {
+	builder := currentAccessibility()
+	if builder == nil {
+		return
+	}
+	var element C.IggAccessibleElement
+	C.iggAccessibleCurrentWindow(&element)
+	node := newAccessibilityNode(role, label, &element)
+	node.ID = 0
+	node.Bounds = AccessibilityBounds{}
+	builder.push(builder.add(node), bounds)
+}
+
+func accessibleWindow(role AccessibilityRole, label string) {
+	if builder := currentAccessibility(); builder != nil {
+		builder.pushWindow(role, label)
+	}
+}
+
+// accessiblePopup starts a popup window if it is open, and returns open.
+func accessiblePopup(name string, open bool) bool {
+	if open {
+		accessibleWindow(AccessibilityRolePopup, name)
+	}
+	return open
+}
+
+func accessibleEnd(roles ...AccessibilityRole) {
+	if builder := currentAccessibility(); builder != nil {
+		builder.pop(roles...)
+	}
+}
+
+// accessibleTableRow starts a new row in the innermost table.
+func accessibleTableRow() *AccessibilityNode {
+	builder := currentAccessibility()
+	if builder == nil {
+		return nil
+	}
+	if !builder.popWithin(AccessibilityRoleTable) {
+		return nil
+	}
+	row := builder.add(&AccessibilityNode{Role: AccessibilityRoleRow, Enabled: true})
+	builder.push(row, accessibilityBoundsFromChildren)
+	builder.containers[len(builder.containers)-1].tableRow = int(C.iggAccessibleTableRow())
+	return row
+}
+
+// accessibleTableCell starts a new cell for the given column in the current row of the innermost table.
+// A negative column selects the current column. A new row is started if the table is in a different row
+// than the current one, such as after TableNextColumn() wrapped around the last column.
+func accessibleTableCell(role AccessibilityRole, column int, label string) *AccessibilityNode {
+	builder := currentAccessibility()
+	if builder == nil {
+		return nil
+	}
+	if !builder.popWithin(AccessibilityRoleRow, AccessibilityRoleTable) {
+		return nil
+	}
+	if !builder.inTableRow(int(C.iggAccessibleTableRow())) && (accessibleTableRow() == nil) {
+		return nil
+	}
+	if column < 0 {
+		column = TableGetColumnIndex()
+	}
+	var element C.IggAccessibleElement
+	if C.iggAccessibleTableCell(C.int(column), &element) == 0 {
+		return nil
+	}
+	cell := builder.add(newAccessibilityNode(role, label, &element))
+	builder.push(cell, accessibilityBoundsFromChildren)
+	return cell
+}
+
+// accessibleTableHeader turns the current cell into a column header.
+func accessibleTableHeader(label string) {
+	builder := currentAccessibility()
+	if builder == nil {
+		return
+	}
+	if cell := builder.top(AccessibilityRoleCell, AccessibilityRoleColumnHeader); cell != nil {
+		cell.Role = AccessibilityRoleColumnHeader
+		cell.Label = displayLabel(label)
+	}
+}
+
+// accessibleValue formats the values of a widget, separated by commas.
+func accessibleValue[T any](values ...T) string {
+	texts := make([]string, len(values))
+	for i, value := range values {
+		texts[i] = fmt.Sprint(value)
+	}
+	return strings.Join(texts, ", ")
+}
+
+// accessibleItemText returns the item at index, or an empty string if the index is out of range.
+func accessibleItemText(items []string, index C.int) string {
+	if (index < 0) || (int(index) >= len(items)) {
+		return ""
+	}
+	return items[index]
+}
+
+func accessibleFlag(value bool) *bool {
+	return &value
+}
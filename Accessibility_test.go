@@ -0,0 +1,152 @@
+package imgui_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+func TestAccessibilityTreeDescribesWidgets(t *testing.T) {
+	newTestContext(t)
+	assert.False(t, imgui.IsAccessibilityEnabled(), "tree should be disabled by default")
+	imgui.SetAccessibilityEnabled(true)
+	require.True(t, imgui.IsAccessibilityEnabled())
+
+	checked := true
+	volume := int32(7)
+	name := "Gopher"
+	secret := "hunter2"
+	var buttonMin, buttonMax imgui.Vec2
+	step := func() {
+		imgui.NewFrame()
+		imgui.SetNextWindowPos(imgui.Vec2{X: 10, Y: 10})
+		imgui.SetNextWindowSize(imgui.Vec2{X: 400, Y: 400})
+		imgui.Begin("Settings")
+		imgui.Button("Save##primary")
+		buttonMin, buttonMax = imgui.ItemRectMin(), imgui.ItemRectMax()
+		imgui.Checkbox("Enabled", &checked)
+		imgui.SliderInt("Volume", &volume, 0, 10)
+		imgui.InputText("Name", &name)
+		imgui.InputTextV("Password", &secret, imgui.InputTextFlagsPassword, nil)
+		imgui.BeginDisabled()
+		imgui.Button("Reset")
+		imgui.EndDisabled()
+		imgui.SetNextItemOpen(true, imgui.ConditionAlways)
+		if imgui.TreeNode("Advanced") {
+			imgui.Text("Details")
+			imgui.TreePop()
+		}
+		if imgui.BeginTable("Grid", 2) {
+			imgui.TableSetupColumn("Key")
+			imgui.TableSetupColumn("Value")
+			imgui.TableHeadersRow()
+			imgui.TableNextRow()
+			imgui.TableNextColumn()
+			imgui.Text("alpha")
+			imgui.TableNextColumn()
+			imgui.Text("1")
+			imgui.EndTable()
+		}
+		imgui.End()
+		imgui.Render()
+	}
+	step()
+	step()
+
+	tree := imgui.LastAccessibilityTree()
+	assert.Equal(t, imgui.AccessibilityTreeVersion, tree.Version)
+	window := tree.Find(imgui.AccessibilityRoleWindow, "Settings")
+	require.NotNil(t, window, "window should be in the tree")
+	assert.Equal(t, "Settings", window.Window)
+	assert.Equal(t, imgui.AccessibilityBounds{X: 10, Y: 10, Width: 400, Height: 400}, window.Bounds)
+
+	button := window.Find(imgui.AccessibilityRoleButton, "Save")
+	require.NotNil(t, button, "button should be in the tree")
+	assert.Equal(t, imgui.GetIDWithSeed("Save##primary", imgui.GetIDWithSeed("Settings", 0)), button.ID)
+	assert.Equal(t, "Settings", button.Window)
+	assert.True(t, button.Enabled)
+	assert.Equal(t, buttonMin, button.Bounds.Min())
+	assert.Equal(t, buttonMax, button.Bounds.Max())
+
+	checkbox := window.Find(imgui.AccessibilityRoleCheckbox, "Enabled")
+	require.NotNil(t, checkbox)
+	require.NotNil(t, checkbox.Checked)
+	assert.True(t, *checkbox.Checked)
+	assert.Equal(t, "7", window.Find(imgui.AccessibilityRoleSlider, "Volume").Value)
+	assert.Equal(t, "Gopher", window.Find(imgui.AccessibilityRoleTextBox, "Name").Value)
+	assert.Equal(t, "", window.Find(imgui.AccessibilityRoleTextBox, "Password").Value, "passwords should not be exposed")
+	assert.False(t, window.Find(imgui.AccessibilityRoleButton, "Reset").Enabled)
+
+	treeNode := window.Find(imgui.AccessibilityRoleTreeNode, "Advanced")
+	require.NotNil(t, treeNode)
+	require.NotNil(t, treeNode.Expanded)
+	assert.True(t, *treeNode.Expanded)
+	require.Len(t, treeNode.Children, 1, "tree node should contain its items")
+	assert.Equal(t, "Details", treeNode.Children[0].Label)
+
+	table := window.Find(imgui.AccessibilityRoleTable, "Grid")
+	require.NotNil(t, table)
+	require.Len(t, table.Children, 2)
+	header, row := table.Children[0], table.Children[1]
+	require.Len(t, header.Children, 2)
+	assert.Equal(t, imgui.AccessibilityRoleColumnHeader, header.Children[0].Role)
+	assert.Equal(t, "Value", header.Children[1].Label)
+	require.Len(t, row.Children, 2)
+	cell := row.Children[1]
+	assert.Equal(t, imgui.AccessibilityRoleCell, cell.Role)
+	require.Len(t, cell.Children, 1)
+	assert.Equal(t, "1", cell.Children[0].Label)
+	assert.True(t, cell.Bounds.X > row.Children[0].Bounds.X, "cells should be laid out in columns")
+	assert.True(t, (table.Bounds.Width > 0) && (table.Bounds.Height > 0), "table should have bounds")
+
+	encoded, err := json.Marshal(tree)
+	require.NoError(t, err)
+	var decoded imgui.AccessibilityTree
+	require.NoError(t, json.Unmarshal(encoded, &decoded))
+	assert.Equal(t, tree, decoded)
+	assert.Contains(t, string(encoded), `{"role":"checkbox","id":`)
+
+	imgui.SetAccessibilityEnabled(false)
+	step()
+	assert.Empty(t, imgui.LastAccessibilityTree().Windows)
+}
+
+func TestAccessibilityTreeStartsRowsWhenColumnsWrapAround(t *testing.T) {
+	newTestContext(t)
+	imgui.SetAccessibilityEnabled(true)
+
+	labels := []string{"a1", "b1", "a2", "b2", "a3", "b3"}
+	step := func() {
+		imgui.NewFrame()
+		imgui.SetNextWindowSize(imgui.Vec2{X: 400, Y: 400})
+		imgui.Begin("Grid")
+		if imgui.BeginTable("Values", 2) {
+			for _, label := range labels {
+				imgui.TableNextColumn()
+				imgui.Text(label)
+			}
+			imgui.EndTable()
+		}
+		imgui.End()
+		imgui.Render()
+	}
+	step()
+	step()
+
+	table := imgui.LastAccessibilityTree().Find(imgui.AccessibilityRoleTable, "Values")
+	require.NotNil(t, table)
+	require.Len(t, table.Children, 3, "table should have a row per two cells")
+	for i, row := range table.Children {
+		assert.Equal(t, imgui.AccessibilityRoleRow, row.Role)
+		require.Len(t, row.Children, 2)
+		for column, cell := range row.Children {
+			require.Len(t, cell.Children, 1)
+			assert.Equal(t, labels[i*2+column], cell.Children[0].Label)
+		}
+	}
+	assert.True(t, table.Children[1].Bounds.Y > table.Children[0].Bounds.Y, "rows should be laid out below each other")
+}
@@ -6,6 +6,10 @@ import (
 )
 
 // AssertHandler is a handler for an assertion that happened in the native part of ImGui.
+//
+// If the handler returns, the native code continues after the failed assertion. This is
+// safe for recoverable errors, such as unbalanced Push/Pop calls, but may crash
+// the process for others.
 type AssertHandler func(expression string, file string, line int)
 
 // AssertionError is the standard error being thrown by the default handler.
@@ -24,7 +28,11 @@ Expression: %s
 `, err.File, err.Line, err.Expression)
 }
 
-var assertHandler AssertHandler = func(expression string, file string, line int) {
+// PanicAssertHandler is the default handler. It panics with an AssertionError.
+//
+// The panic unwinds the native stack of the ImGui call that failed, and can be recovered
+// like any other panic. The state of ImGui may not be consistent after such a recovery.
+func PanicAssertHandler(expression string, file string, line int) {
 	panic(AssertionError{
 		Expression: expression,
 		File:       file,
@@ -32,16 +40,60 @@ var assertHandler AssertHandler = func(expression string, file string, line int)
 	})
 }
 
-// SetAssertHandler registers a handler function for all future assertions.
+// SetAssertHandler registers a handler function for all future assertions of the current context.
 // Setting nil will disable special handling.
-// The default handler panics.
+// The default handler is PanicAssertHandler.
+//
+// If no context is current, the handler becomes the one that contexts created afterwards start with.
 func SetAssertHandler(handler AssertHandler) {
-	assertHandler = handler
+	state := currentState()
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	state.assertHandler = handler
+}
+
+// RecordingAssertHandler records the failed assertion instead of panicking.
+// The assertions recorded for the current frame are returned by FrameAssertions().
+//
+// Use this handler together with the error recovery of ImGui, which is enabled by default,
+// to keep an application running despite unbalanced Push/Pop or Begin/End calls.
+func RecordingAssertHandler(expression string, file string, line int) {
+	state := currentState()
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	state.recordedAssertions = append(state.recordedAssertions, AssertionError{
+		Expression: expression,
+		File:       file,
+		Line:       line,
+	})
+}
+
+// FrameAssertions returns the assertions recorded by RecordingAssertHandler since the last call to NewFrame()
+// of the current context.
+// This includes assertions of the end of the frame that are detected during Render().
+func FrameAssertions() []AssertionError {
+	state := currentState()
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	result := make([]AssertionError, len(state.recordedAssertions))
+	copy(result, state.recordedAssertions)
+	return result
+}
+
+func clearFrameAssertions() {
+	state := currentState()
+	state.mutex.Lock()
+	defer state.mutex.Unlock()
+	state.recordedAssertions = nil
 }
 
 //export iggAssert
 func iggAssert(expression *C.char, file *C.char, line C.int) {
-	if assertHandler != nil {
-		assertHandler(C.GoString(expression), C.GoString(file), int(line))
+	state := currentState()
+	state.mutex.Lock()
+	handler := state.assertHandler
+	state.mutex.Unlock()
+	if handler != nil {
+		handler(C.GoString(expression), C.GoString(file), int(line))
 	}
 }
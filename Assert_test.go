@@ -0,0 +1,45 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+func TestFailedAssertionPanicsWithAssertionError(t *testing.T) {
+	newTestContext(t)
+
+	imgui.NewFrame()
+	defer imgui.EndFrame()
+
+	var recovered interface{}
+	func() {
+		defer func() { recovered = recover() }()
+		imgui.PopStyleVar()
+	}()
+
+	require.IsType(t, imgui.AssertionError{}, recovered)
+	assert.Contains(t, recovered.(imgui.AssertionError).Expression, "PopStyleVar")
+}
+
+func TestRecordingAssertHandlerCollectsFrameAssertions(t *testing.T) {
+	newTestContext(t)
+
+	imgui.SetAssertHandler(imgui.RecordingAssertHandler)
+	defer imgui.SetAssertHandler(imgui.PanicAssertHandler)
+
+	imgui.NewFrame()
+	imgui.PopStyleVar()
+	imgui.Render()
+
+	assertions := imgui.FrameAssertions()
+	require.Len(t, assertions, 1)
+	assert.Contains(t, assertions[0].Expression, "PopStyleVar")
+
+	imgui.NewFrame()
+	imgui.Render()
+	assert.Empty(t, imgui.FrameAssertions(), "assertions should be cleared for the new frame")
+}
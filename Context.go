@@ -2,6 +2,8 @@ package imgui
 
 import (
 	"errors"
+	"runtime/cgo"
+	"sync"
 )
 
 // #include "wrapper/Context.h"
@@ -11,20 +13,85 @@ import "C"
 //
 // All contexts share a same FontAtlas by default.
 // If you want different font atlas, you can create them and overwrite the CurrentIO.Fonts of an ImGui context.
+//
+// Each context has its own Go side state: the clipboard registered via PlatformIO.SetClipboard(),
+// the assert handler set with SetAssertHandler(), the state of active text input fields, the accessibility tree,
+// and the functions added with DrawList.AddCallback().
+// Functions operating on this state use the state of the current context.
 type Context struct {
 	handle C.IggContext
+	state  *contextState
+}
+
+// contextState is the Go side state of a context.
+type contextState struct {
+	mutex sync.Mutex
+
+	clipboard           Clipboard
+	freeClipboardString func()
+
+	assertHandler      AssertHandler
+	recordedAssertions []AssertionError
+
+	inputTextStates map[C.int]*inputTextState
+
+	accessibility accessibilityBuilder
+
+	drawCallbacks []cgo.Handle
+}
+
+func newContextState(handler AssertHandler) *contextState {
+	return &contextState{
+		assertHandler:   handler,
+		inputTextStates: make(map[C.int]*inputTextState),
+	}
+}
+
+var contextStates = make(map[C.IggContext]*contextState)
+var contextStatesMutex sync.Mutex
+
+// detachedState is used while no context is current. Its assert handler is the one
+// that new contexts start with.
+var detachedState = newContextState(PanicAssertHandler)
+
+// stateFor returns the state of the context with the given handle.
+// The detached state is returned for unknown handles.
+func stateFor(handle C.IggContext) *contextState {
+	contextStatesMutex.Lock()
+	defer contextStatesMutex.Unlock()
+	if state, known := contextStates[handle]; known {
+		return state
+	}
+	return detachedState
+}
+
+// currentState returns the state of the currently active context.
+func currentState() *contextState {
+	return stateFor(C.iggGetCurrentContext())
 }
 
 // CreateContext produces a new internal state scope.
 // Passing nil for the fontAtlas creates a default font.
 //
+// The new context starts with the assert handler that was set while no context was current,
+// which is PanicAssertHandler by default.
+//
 // Deprecated: Unmaintained wrapper. Use an alternative; see README of https://github.com/inkyblackness/imgui-go .
 func CreateContext(fontAtlas *FontAtlas) *Context {
 	var fontAtlasPtr C.IggFontAtlas
 	if fontAtlas != nil {
 		fontAtlasPtr = fontAtlas.handle()
 	}
-	return &Context{handle: C.iggCreateContext(fontAtlasPtr)}
+	detachedState.mutex.Lock()
+	state := newContextState(detachedState.assertHandler)
+	detachedState.mutex.Unlock()
+
+	handle := C.iggCreateContext(fontAtlasPtr)
+
+	contextStatesMutex.Lock()
+	defer contextStatesMutex.Unlock()
+	contextStates[handle] = state
+	return &Context{handle: handle, state: state}
 }
 
 // ErrNoContext is used when no context is current.
@@ -37,7 +104,7 @@ func CurrentContext() (*Context, error) {
 	if raw == nil {
 		return nil, ErrNoContext
 	}
-	return &Context{handle: raw}, nil
+	return &Context{handle: raw, state: stateFor(raw)}, nil
 }
 
 // Destroy removes the internal state scope.
@@ -45,6 +112,16 @@ func CurrentContext() (*Context, error) {
 func (context *Context) Destroy() {
 	if context.handle != nil {
 		C.iggDestroyContext(context.handle)
+
+		contextStatesMutex.Lock()
+		delete(contextStates, context.handle)
+		contextStatesMutex.Unlock()
+		context.state.mutex.Lock()
+		context.state.releaseClipboardString()
+		context.state.mutex.Unlock()
+		context.state.accessibility.setEnabled(false)
+		context.state.releaseDrawCallbacks()
+
 		context.handle = nil
 	}
 }
@@ -53,6 +130,7 @@ func (context *Context) Destroy() {
 var ErrContextDestroyed = errors.New("context is destroyed")
 
 // SetCurrent activates this context as the currently active state scope.
+// This also activates the Go side state of the context.
 func (context Context) SetCurrent() error {
 	if context.handle == nil {
 		return ErrContextDestroyed
@@ -69,3 +69,70 @@ func TestSetCurrentReturnsErrorWhenContextDestroyed(t *testing.T) {
 	err := context.SetCurrent()
 	assert.Equal(t, ErrContextDestroyed, err)
 }
+
+type testClipboard string
+
+func (board testClipboard) ClipboardText() (string, error) { return string(board), nil }
+func (board testClipboard) SetClipboardText(string)        {}
+
+func TestContextsHaveSeparateGoState(t *testing.T) {
+	context1 := CreateContext(nil)
+	require.NotNil(t, context1, "Context expected")
+	defer context1.Destroy()
+	context2 := CreateContext(nil)
+	require.NotNil(t, context2, "Context expected")
+	defer context2.Destroy()
+
+	var handled string
+	_ = context1.SetCurrent()
+	SetAssertHandler(func(expression string, file string, line int) { handled = "first" })
+	CurrentPlatformIO().SetClipboard(testClipboard("first"))
+	_ = context2.SetCurrent()
+	SetAssertHandler(func(expression string, file string, line int) { handled = "second" })
+
+	assert.Equal(t, testClipboard("first"), context1.state.clipboard, "first context should keep its clipboard")
+	assert.Nil(t, context2.state.clipboard, "second context should have no clipboard")
+
+	currentState().assertHandler("", "", 0)
+	assert.Equal(t, "second", handled, "handler of second context expected")
+
+	_ = context1.SetCurrent()
+	current, _ := CurrentContext()
+	assert.True(t, current.state == context1.state, "current context should carry the state of the first context")
+	currentState().assertHandler("", "", 0)
+	assert.Equal(t, "first", handled, "handler of first context expected")
+}
+
+func TestNewContextStartsWithDetachedAssertHandler(t *testing.T) {
+	context := CreateContext(nil)
+	require.NotNil(t, context, "Context expected")
+	defer context.Destroy()
+
+	assert.NotNil(t, context.state.assertHandler, "assert handler expected")
+	assert.Empty(t, context.state.inputTextStates, "no input text states expected")
+}
+
+func TestDrawCallbacksAreReleasedAfterTheirFrame(t *testing.T) {
+	context := CreateContext(nil)
+	defer context.Destroy()
+	io := CurrentIO()
+	io.SetIniFilename("")
+	io.SetDisplaySize(Vec2{X: 100, Y: 100})
+	io.Fonts().TextureDataRGBA32()
+
+	NewFrame()
+	ForegroundDrawList().AddCallback(func(DrawList, DrawCommand) {})
+	ForegroundDrawList().AddCallback(ResetRenderState)
+	Render()
+	require.Len(t, context.state.drawCallbacks, 1, "callback should be kept until the frame is rendered")
+	handle := context.state.drawCallbacks[0]
+
+	NewFrame()
+	assert.Empty(t, context.state.drawCallbacks, "callback should be released with the next frame")
+	assert.Panics(t, func() { handle.Value() }, "handle should be deleted")
+	ForegroundDrawList().AddCallback(func(DrawList, DrawCommand) {})
+	Render()
+
+	context.Destroy()
+	assert.Empty(t, context.state.drawCallbacks, "callbacks should be released with the context")
+}
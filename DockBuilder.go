@@ -0,0 +1,158 @@
+package imgui
+
+// #include "wrapper/DockBuilder.h"
+import "C"
+
+// DockBuilder
+// The DockBuilder functions create and modify dock nodes programmatically.
+// They are part of the internal API of Dear ImGui and may change with future versions.
+// - To create a DockSpace() node, set DockNodeFlagsDockSpace when calling DockBuilderAddNode().
+// - If you intend to split the node immediately after creation using DockBuilderSplitNode(), make sure
+//   to call DockBuilderSetNodeSize() beforehand. If you don't, the resulting split sizes may not be reliable.
+// - Call DockBuilderFinish() after you are done.
+// DockLayout provides a declarative alternative to calling these functions directly.
+
+// DockNodeFlagsDockSpace marks a node created with DockBuilderAddNode() as a dock space,
+// which occupies space within an existing window. Otherwise the node is floating and creates its own window.
+const DockNodeFlagsDockSpace DockNodeFlags = 1 << 10
+
+// DockBuilderNodeExists returns true if a dock node with given ID exists.
+// Nodes that are stored in the .ini file exist from the first frame on.
+func DockBuilderNodeExists(nodeID ID) bool {
+	return C.iggDockBuilderNodeExists(C.uint(nodeID)) != 0
+}
+
+// DockBuilderGetCentralNode returns the ID of the central node of the hierarchy the given node belongs to.
+// It returns 0 if the node does not exist.
+func DockBuilderGetCentralNode(nodeID ID) ID {
+	return ID(C.iggDockBuilderGetCentralNode(C.uint(nodeID)))
+}
+
+// DockBuilderAddNode creates a new dock node and returns its ID. An ID of 0 lets ImGui choose one.
+func DockBuilderAddNode(nodeID ID, flags DockNodeFlags) ID {
+	return ID(C.iggDockBuilderAddNode(C.uint(nodeID), C.int(flags)))
+}
+
+// DockBuilderRemoveNode removes the node and all its children, and undocks all windows.
+func DockBuilderRemoveNode(nodeID ID) {
+	C.iggDockBuilderRemoveNode(C.uint(nodeID))
+}
+
+// DockBuilderRemoveNodeDockedWindows undocks all windows of the node.
+func DockBuilderRemoveNodeDockedWindows(nodeID ID, clearSettingsRefs bool) {
+	C.iggDockBuilderRemoveNodeDockedWindows(C.uint(nodeID), castBool(clearSettingsRefs))
+}
+
+// DockBuilderRemoveNodeChildNodes removes all split/hierarchy.
+// All remaining docked windows will be re-docked to the remaining root node.
+func DockBuilderRemoveNodeChildNodes(nodeID ID) {
+	C.iggDockBuilderRemoveNodeChildNodes(C.uint(nodeID))
+}
+
+// DockBuilderSetNodePos sets the position of a node.
+func DockBuilderSetNodePos(nodeID ID, pos Vec2) {
+	posArg, _ := pos.wrapped()
+	C.iggDockBuilderSetNodePos(C.uint(nodeID), posArg)
+}
+
+// DockBuilderSetNodeSize sets the size of a node.
+func DockBuilderSetNodeSize(nodeID ID, size Vec2) {
+	sizeArg, _ := size.wrapped()
+	C.iggDockBuilderSetNodeSize(C.uint(nodeID), sizeArg)
+}
+
+// DockBuilderSplitNode creates two child nodes within the given node, which becomes their parent.
+// The node in the given direction receives sizeRatioForNodeAtDir of the available space.
+// It returns the IDs of the node in the given direction and of the node in the opposite direction.
+func DockBuilderSplitNode(nodeID ID, splitDir Dir, sizeRatioForNodeAtDir float32) (atDir ID, atOppositeDir ID) {
+	var atDirArg C.uint
+	var atOppositeDirArg C.uint
+	C.iggDockBuilderSplitNode(C.uint(nodeID), C.IggDir(splitDir), C.float(sizeRatioForNodeAtDir), &atDirArg, &atOppositeDirArg)
+	return ID(atDirArg), ID(atOppositeDirArg)
+}
+
+// DockBuilderDockWindow docks the window with given name into the node.
+// The window does not need to exist yet.
+func DockBuilderDockWindow(windowName string, nodeID ID) {
+	windowNameArg, windowNameFin := wrapString(windowName)
+	defer windowNameFin()
+	C.iggDockBuilderDockWindow(windowNameArg, C.uint(nodeID))
+}
+
+// DockBuilderFinish completes the changes to the node and its children.
+func DockBuilderFinish(nodeID ID) {
+	C.iggDockBuilderFinish(C.uint(nodeID))
+}
+
+// DockLayout is a declarative description of a tree of dock nodes.
+// A node is either split into two further nodes, or hosts a list of windows.
+//
+// The following layout has a file list on the left, and a log below the editor:
+//
+//	layout := imgui.DockLayout{Split: &imgui.DockSplit{
+//		Dir:   imgui.DirLeft,
+//		Ratio: 0.25,
+//		At:    imgui.DockLayout{Windows: []string{"Files"}},
+//		Rest: imgui.DockLayout{Split: &imgui.DockSplit{
+//			Dir:   imgui.DirDown,
+//			Ratio: 0.3,
+//			At:    imgui.DockLayout{Windows: []string{"Log"}},
+//			Rest:  imgui.DockLayout{Windows: []string{"Editor"}},
+//		}},
+//	}}
+//
+//	// in every frame, before the windows are submitted:
+//	dockSpaceID := imgui.GetID("Workspace")
+//	layout.ApplyOnce(dockSpaceID, imgui.MainViewport().WorkSize())
+//	imgui.DockSpaceOverViewportV(dockSpaceID, imgui.MainViewport(), 0, nil)
+type DockLayout struct {
+	// Split divides the node into two further nodes. If nil, the node hosts the windows.
+	Split *DockSplit
+	// Windows are the names of the windows docked into the node. They are ignored if the node is split.
+	Windows []string
+}
+
+// DockSplit describes the division of a dock node.
+type DockSplit struct {
+	// Dir is the direction of the At node.
+	Dir Dir
+	// Ratio is the share of the space the At node receives, in the range (0,1).
+	Ratio float32
+	// At is the node in direction Dir.
+	At DockLayout
+	// Rest is the node in the opposite direction, receiving the remaining space.
+	Rest DockLayout
+}
+
+// Apply replaces the node with given ID by a dock space of given size, organized according to the layout.
+// Call it before the corresponding DockSpace() or DockSpaceOverViewportV() call.
+func (layout DockLayout) Apply(dockSpaceID ID, size Vec2) {
+	DockBuilderRemoveNode(dockSpaceID)
+	DockBuilderAddNode(dockSpaceID, DockNodeFlagsDockSpace)
+	DockBuilderSetNodeSize(dockSpaceID, size)
+	layout.build(dockSpaceID)
+	DockBuilderFinish(dockSpaceID)
+}
+
+// ApplyOnce calls Apply() only if the dock space does not exist yet, and returns whether it did.
+// As dock nodes are stored in the .ini file, this applies the layout on the first run of an application,
+// and keeps the arrangement of the user afterwards.
+func (layout DockLayout) ApplyOnce(dockSpaceID ID, size Vec2) bool {
+	if DockBuilderNodeExists(dockSpaceID) {
+		return false
+	}
+	layout.Apply(dockSpaceID, size)
+	return true
+}
+
+func (layout DockLayout) build(nodeID ID) {
+	if layout.Split != nil {
+		atDir, atOppositeDir := DockBuilderSplitNode(nodeID, layout.Split.Dir, layout.Split.Ratio)
+		layout.Split.At.build(atDir)
+		layout.Split.Rest.build(atOppositeDir)
+		return
+	}
+	for _, name := range layout.Windows {
+		DockBuilderDockWindow(name, nodeID)
+	}
+}
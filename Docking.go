@@ -0,0 +1,142 @@
+package imgui
+
+// #include "wrapper/Docking.h"
+import "C"
+
+// Docking
+// Requires ConfigFlagsDockingEnable to be set in IO.SetConfigFlags().
+// - Drag from window title bar or their tab to dock/undock. Hold SHIFT to disable docking.
+// - Drag from window menu button (upper-left button) to undock an entire node (all windows).
+// About dockspaces:
+// - Use DockSpaceOverViewport() to create a window covering the screen or a specific viewport + a dockspace inside it.
+// - Use DockSpace() to create an explicit dock node _within_ an existing window.
+// - Dockspaces need to be submitted _before_ any window they can host. Submit it early in your frame!
+// - Dockspaces need to be kept alive if hidden, otherwise windows docked into it will be undocked.
+
+// DockNodeFlags for DockSpaceV(), DockSpaceOverViewportV() and WindowClass.DockNodeFlagsOverrideSet.
+type DockNodeFlags int
+
+const (
+	// DockNodeFlagsNone specifies the default behavior.
+	DockNodeFlagsNone DockNodeFlags = 0
+	// DockNodeFlagsKeepAliveOnly doesn't display the dockspace node but keeps it alive.
+	// Windows docked into this dockspace node won't be undocked.
+	DockNodeFlagsKeepAliveOnly DockNodeFlags = 1 << 0
+	// DockNodeFlagsNoDockingOverCentralNode disables docking over the Central Node, which will be always kept empty.
+	DockNodeFlagsNoDockingOverCentralNode DockNodeFlags = 1 << 2
+	// DockNodeFlagsPassthruCentralNode enables passthru dockspace: 1) DockSpace() will render a ImGuiCol_WindowBg background
+	// covering everything excepted the Central Node when empty. Meaning the host window should probably use
+	// SetNextWindowBgAlpha(0.0f) prior to Begin() when using this. 2) When Central Node is empty: let inputs pass-through
+	// + won't display a DockingEmptyBg background.
+	DockNodeFlagsPassthruCentralNode DockNodeFlags = 1 << 3
+	// DockNodeFlagsNoDockingSplit disables other windows/nodes from splitting this node.
+	DockNodeFlagsNoDockingSplit DockNodeFlags = 1 << 4
+	// DockNodeFlagsNoResize disables resizing node using the splitter/separators. Useful with programmatically setup dockspaces.
+	DockNodeFlagsNoResize DockNodeFlags = 1 << 5
+	// DockNodeFlagsAutoHideTabBar hides the tab bar automatically when there is a single window in the dock node.
+	DockNodeFlagsAutoHideTabBar DockNodeFlags = 1 << 6
+	// DockNodeFlagsNoUndocking disables undocking this node.
+	DockNodeFlagsNoUndocking DockNodeFlags = 1 << 7
+)
+
+// WindowClass specifies a class of windows, for SetNextWindowClass() and the dockspace functions.
+// Docking is only possible between windows of the same class, and the class can override
+// flags of the viewport, the tab item and the dock node of its windows.
+//
+// Use NewWindowClass() for a value with the defaults of ImGui.
+type WindowClass struct {
+	// ClassID is the user data. 0 = Default class (unclassed). Windows of different classes cannot be docked with each others.
+	ClassID ID
+	// ParentViewportID hints for the platform backend. -1: use default. 0: request platform backend to not parent the platform.
+	// != 0: request platform backend to create a parent<>child relationship between the platform windows.
+	ParentViewportID ID
+	// FocusRouteParentWindowID is the ID of the parent window for shortcut focus route evaluation.
+	FocusRouteParentWindowID ID
+	// ViewportFlagsOverrideSet are viewport flags to set when a window of this class owns a viewport.
+	ViewportFlagsOverrideSet ViewportFlags
+	// ViewportFlagsOverrideClear are viewport flags to clear when a window of this class owns a viewport.
+	ViewportFlagsOverrideClear ViewportFlags
+	// TabItemFlagsOverrideSet are tab item flags to set when a window of this class gets submitted into a dock node tab bar.
+	TabItemFlagsOverrideSet TabItemFlags
+	// DockNodeFlagsOverrideSet are dock node flags to set when a window of this class is hosted by a dock node.
+	DockNodeFlagsOverrideSet DockNodeFlags
+	// DockingAlwaysTabBar forces a tab bar even for a single window, when docked.
+	DockingAlwaysTabBar bool
+	// DockingAllowUnclassed allows docking windows of this class with unclassed windows.
+	DockingAllowUnclassed bool
+}
+
+// NewWindowClass returns a WindowClass with the defaults of ImGui.
+func NewWindowClass() WindowClass {
+	return WindowClass{
+		ParentViewportID:      ^ID(0),
+		DockingAllowUnclassed: true,
+	}
+}
+
+func (class *WindowClass) wrapped() *C.IggWindowClass {
+	if class == nil {
+		return nil
+	}
+	return &C.IggWindowClass{
+		ClassId:                    C.uint(class.ClassID),
+		ParentViewportId:           C.uint(class.ParentViewportID),
+		FocusRouteParentWindowId:   C.uint(class.FocusRouteParentWindowID),
+		ViewportFlagsOverrideSet:   C.int(class.ViewportFlagsOverrideSet),
+		ViewportFlagsOverrideClear: C.int(class.ViewportFlagsOverrideClear),
+		TabItemFlagsOverrideSet:    C.int(class.TabItemFlagsOverrideSet),
+		DockNodeFlagsOverrideSet:   C.int(class.DockNodeFlagsOverrideSet),
+		DockingAlwaysTabBar:        castBool(class.DockingAlwaysTabBar),
+		DockingAllowUnclassed:      castBool(class.DockingAllowUnclassed),
+	}
+}
+
+// DockSpaceV creates an explicit dock node within the current window, and returns its ID.
+// A size of zero uses all available space. The window class may be nil.
+func DockSpaceV(id ID, size Vec2, flags DockNodeFlags, class *WindowClass) ID {
+	sizeArg, _ := size.wrapped()
+	return ID(C.iggDockSpace(C.uint(id), sizeArg, C.int(flags), class.wrapped()))
+}
+
+// DockSpace calls DockSpaceV(id, Vec2{}, 0, nil).
+func DockSpace(id ID) ID {
+	return DockSpaceV(id, Vec2{}, 0, nil)
+}
+
+// DockSpaceOverViewportV creates a window covering the given viewport, with a dock space inside it,
+// and returns the ID of the dock space.
+// An id of zero lets ImGui choose one; the window class may be nil.
+func DockSpaceOverViewportV(id ID, viewport Viewport, flags DockNodeFlags, class *WindowClass) ID {
+	return ID(C.iggDockSpaceOverViewport(C.uint(id), viewport.handle(), C.int(flags), class.wrapped()))
+}
+
+// DockSpaceOverViewport calls DockSpaceOverViewportV(0, MainViewport(), 0, nil).
+func DockSpaceOverViewport() ID {
+	return DockSpaceOverViewportV(0, MainViewport(), 0, nil)
+}
+
+// SetNextWindowDockIDV sets the dock node the next window is docked into. Call before Begin().
+func SetNextWindowDockIDV(id ID, cond Condition) {
+	C.iggSetNextWindowDockID(C.uint(id), C.int(cond))
+}
+
+// SetNextWindowDockID calls SetNextWindowDockIDV(id, 0).
+func SetNextWindowDockID(id ID) {
+	SetNextWindowDockIDV(id, 0)
+}
+
+// SetNextWindowClass sets the class of the next window. Call before Begin().
+// Docking is only possible between windows of the same class.
+func SetNextWindowClass(class WindowClass) {
+	C.iggSetNextWindowClass(class.wrapped())
+}
+
+// GetWindowDockID returns the ID of the dock node the current window is docked into, or 0 if not docked.
+func GetWindowDockID() ID {
+	return ID(C.iggGetWindowDockID())
+}
+
+// IsWindowDocked returns true if the current window is docked into a dock node.
+func IsWindowDocked() bool {
+	return C.iggIsWindowDocked() != 0
+}
@@ -0,0 +1,70 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/jetsetilly/imgui-go/v5"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindowCanBeDockedIntoDockSpace(t *testing.T) {
+	newTestContext(t)
+
+	io := imgui.CurrentIO()
+	io.SetConfigFlags(imgui.ConfigFlagsDockingEnable)
+
+	var dockID imgui.ID
+	var windowDockID imgui.ID
+	var docked bool
+	for frame := 0; frame < 3; frame++ {
+		imgui.NewFrame()
+		dockID = imgui.DockSpaceOverViewport()
+		imgui.SetNextWindowDockID(dockID)
+		imgui.SetNextWindowClass(imgui.NewWindowClass())
+		imgui.Begin("Docked")
+		windowDockID = imgui.GetWindowDockID()
+		docked = imgui.IsWindowDocked()
+		imgui.End()
+		imgui.Render()
+	}
+
+	assert.NotEqual(t, imgui.ID(0), dockID, "dock space ID expected")
+	assert.Equal(t, dockID, windowDockID, "window should be docked into dock space")
+	assert.True(t, docked, "window should report being docked")
+}
+
+func TestDockLayoutIsAppliedOnce(t *testing.T) {
+	newTestContext(t)
+
+	io := imgui.CurrentIO()
+	io.SetConfigFlags(imgui.ConfigFlagsDockingEnable)
+
+	layout := imgui.DockLayout{Split: &imgui.DockSplit{
+		Dir:   imgui.DirLeft,
+		Ratio: 0.25,
+		At:    imgui.DockLayout{Windows: []string{"Files"}},
+		Rest:  imgui.DockLayout{Windows: []string{"Editor", "Log"}},
+	}}
+
+	var applied []bool
+	windowDockIDs := make(map[string]imgui.ID)
+	for frame := 0; frame < 3; frame++ {
+		imgui.NewFrame()
+		dockSpaceID := imgui.GetID("Workspace")
+		applied = append(applied, layout.ApplyOnce(dockSpaceID, imgui.MainViewport().WorkSize()))
+		imgui.DockSpaceOverViewportV(dockSpaceID, imgui.MainViewport(), 0, nil)
+		for _, name := range []string{"Files", "Editor", "Log"} {
+			imgui.Begin(name)
+			windowDockIDs[name] = imgui.GetWindowDockID()
+			imgui.End()
+		}
+		imgui.Render()
+	}
+
+	assert.Equal(t, []bool{true, false, false}, applied)
+	assert.NotEqual(t, imgui.ID(0), windowDockIDs["Files"], "Files should be docked")
+	assert.NotEqual(t, imgui.ID(0), windowDockIDs["Editor"], "Editor should be docked")
+	assert.NotEqual(t, windowDockIDs["Files"], windowDockIDs["Editor"], "Files and Editor should be in different nodes")
+	assert.Equal(t, windowDockIDs["Editor"], windowDockIDs["Log"], "Editor and Log should share a node")
+}
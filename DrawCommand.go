@@ -2,6 +2,7 @@ package imgui
 
 // #include "wrapper/DrawCommand.h"
 import "C"
+import "runtime/cgo"
 
 // DrawCommand describes one GPU call (or a callback).
 type DrawCommand uintptr
@@ -57,8 +58,26 @@ func (cmd DrawCommand) HasUserCallback() bool {
 	return C.iggDrawCommandHasUserCallback(cmd.handle()) != 0
 }
 
+// IsResetRenderState returns true if the command is a request to reset the render state,
+// added with DrawList.AddCallback(ResetRenderState). HasUserCallback() is true for such commands as well.
+func (cmd DrawCommand) IsResetRenderState() bool {
+	return C.iggDrawCommandIsResetRenderState(cmd.handle()) != 0
+}
+
 // CallUserCallback calls the user callback instead of rendering the vertices.
 // ClipRect and TextureID will be set normally.
+// A request to reset the render state is not a function; it is ignored.
 func (cmd DrawCommand) CallUserCallback(list DrawList) {
 	C.iggDrawCommandCallUserCallback(cmd.handle(), list.handle())
 }
+
+// Callback returns the function that was added with DrawList.AddCallback(), so that Go renderers
+// can call it without going through C. It returns false for commands without a Go function,
+// including the requests to reset the render state.
+func (cmd DrawCommand) Callback() (DrawCallback, bool) {
+	var callback C.uintptr_t
+	if C.iggDrawCommandGetGoCallback(cmd.handle(), &callback) == 0 {
+		return nil, false
+	}
+	return cgo.Handle(callback).Value().(DrawCallback), true
+}
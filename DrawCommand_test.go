@@ -0,0 +1,119 @@
+package imgui_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// renderCallbackWindow renders a frame with a window, in which draw adds callbacks to the draw list of the window.
+// The window has an explicit size, so that it is not hidden in its first frame, and its draw list is rendered.
+func renderCallbackWindow(draw func(list imgui.DrawList)) {
+	imgui.NewFrame()
+	imgui.SetNextWindowSize(imgui.Vec2{X: 300, Y: 200})
+	imgui.Begin("callbacks")
+	draw(imgui.WindowDrawList())
+	imgui.End()
+	imgui.Render()
+}
+
+// userCallbacks returns the commands of the rendered draw data that have a user callback, with their lists.
+func userCallbacks() (lists []imgui.DrawList, commands []imgui.DrawCommand) {
+	for _, list := range imgui.RenderedDrawData().CommandLists() {
+		for _, cmd := range list.Commands() {
+			if cmd.HasUserCallback() {
+				lists = append(lists, list)
+				commands = append(commands, cmd)
+			}
+		}
+	}
+	return
+}
+
+func TestDrawListCallbacksCanBeCalledByRenderers(t *testing.T) {
+	newTestContext(t)
+
+	type call struct {
+		list imgui.DrawList
+		cmd  imgui.DrawCommand
+	}
+	var calls []call
+	var target imgui.DrawList
+	renderCallbackWindow(func(list imgui.DrawList) {
+		target = list
+		list.AddCallback(func(list imgui.DrawList, cmd imgui.DrawCommand) {
+			calls = append(calls, call{list: list, cmd: cmd})
+		})
+		list.AddCallback(imgui.ResetRenderState)
+		list.AddCallback(nil)
+	})
+
+	lists, commands := userCallbacks()
+	require.Len(t, commands, 2, "nil callback should be ignored")
+	assert.Equal(t, []imgui.DrawList{target, target}, lists)
+
+	assert.False(t, commands[0].IsResetRenderState())
+	callback, isGo := commands[0].Callback()
+	require.True(t, isGo, "callback should be the Go function")
+	callback(lists[0], commands[0])
+	commands[0].CallUserCallback(lists[0])
+	assert.Equal(t, []call{{target, commands[0]}, {target, commands[0]}}, calls,
+		"callback should be called directly, and through the command")
+
+	assert.True(t, commands[1].IsResetRenderState())
+	_, isGo = commands[1].Callback()
+	assert.False(t, isGo, "reset of the render state should not be a Go function")
+	commands[1].CallUserCallback(lists[1])
+	assert.Len(t, calls, 2, "reset of the render state should not call anything")
+}
+
+func TestDrawListCallbacksAreNotEncoded(t *testing.T) {
+	newTestContext(t)
+
+	renderCallbackWindow(func(list imgui.DrawList) {
+		list.AddCallback(func(imgui.DrawList, imgui.DrawCommand) {})
+		list.AddCallback(imgui.ResetRenderState)
+	})
+
+	var encoded bytes.Buffer
+	require.NoError(t, imgui.RenderedDrawData().Encode(&encoded))
+	decoded, err := imgui.DecodeDrawData(&encoded)
+	require.NoError(t, err)
+	defer decoded.Delete()
+	resets := 0
+	for _, list := range decoded.DrawData().CommandLists() {
+		for _, cmd := range list.Commands() {
+			_, isGo := cmd.Callback()
+			assert.False(t, isGo, "Go functions should not be encoded")
+			if cmd.IsResetRenderState() {
+				resets++
+			}
+		}
+	}
+	assert.Equal(t, 1, resets, "reset of the render state should be encoded")
+}
+
+func TestDrawListCallbacksAreIgnoredWithoutContext(t *testing.T) {
+	context := newTestContext(t)
+	renderCallbackWindow(func(imgui.DrawList) {})
+	var encoded bytes.Buffer
+	require.NoError(t, imgui.RenderedDrawData().Encode(&encoded))
+	context.Destroy()
+	_, err := imgui.CurrentContext()
+	require.Equal(t, imgui.ErrNoContext, err)
+
+	decoded, err := imgui.DecodeDrawData(&encoded)
+	require.NoError(t, err)
+	defer decoded.Delete()
+	lists := decoded.DrawData().CommandLists()
+	require.NotEmpty(t, lists)
+	commands := len(lists[0].Commands())
+	lists[0].AddCallback(func(imgui.DrawList, imgui.DrawCommand) {})
+	assert.Len(t, lists[0].Commands(), commands, "callback should be ignored")
+	lists[0].AddCallback(imgui.ResetRenderState)
+	assert.True(t, len(lists[0].Commands()) > commands, "reset of the render state needs no context")
+}
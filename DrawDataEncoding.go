@@ -0,0 +1,624 @@
+package imgui
+
+// #include "wrapper/DrawData.h"
+import "C"
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"unsafe"
+)
+
+// DrawDataMagic is the signature at the start of every binary encoded DrawData.
+const DrawDataMagic = "IGDD"
+
+// DrawDataEncodingVersion is the version of the encodings written by DrawData.Encode() and DrawData.EncodeJSON().
+const DrawDataEncodingVersion = 1
+
+// ErrInvalidDrawData is returned by the decoding functions if the data is not an encoded DrawData, or is corrupt.
+var ErrInvalidDrawData = errors.New("invalid draw data encoding")
+
+// ErrUnsupportedDrawDataVersion is returned by the decoding functions if the data was written with a newer version.
+var ErrUnsupportedDrawDataVersion = errors.New("unsupported draw data encoding version")
+
+// Encode writes the draw data in a binary format: the display position, size and frame buffer scale,
+// and the vertices, indices and commands of every command list.
+//
+// Vertices and indices are written as they are in memory, together with VertexBufferLayout() and
+// IndexBufferLayout(), so the encoding is compact and exact. Decoding converts them to the layout of
+// the decoding program, should that differ.
+//
+// User callbacks can't be encoded; their commands are left out, except for the reset of the render state.
+//
+// Every encoding is self-contained. Encoding several frames into the same writer records them,
+// and calling DecodeDrawData() repeatedly replays them, until it returns io.EOF.
+func (data DrawData) Encode(w io.Writer) error {
+	content := captureDrawData(data)
+	out := bufio.NewWriter(w)
+	content.writeBinary(out)
+	return out.Flush()
+}
+
+// EncodeJSON writes the draw data as JSON, with the fields of every vertex spelled out.
+// It is larger than the binary encoding, but readable, and independent of the vertex layout.
+func (data DrawData) EncodeJSON(w io.Writer) error {
+	return json.NewEncoder(w).Encode(captureDrawData(data).toJSON())
+}
+
+// DecodedDrawData is draw data that was decoded with DecodeDrawData() or DecodeDrawDataJSON().
+//
+// It is owned by the caller instead of a context, and needs to be released with Delete().
+// No context is required to decode or to render it.
+type DecodedDrawData struct {
+	handle C.IggDrawData
+}
+
+// DrawData returns the decoded draw data, for a renderer to consume like the draw data of RenderedDrawData().
+// It is valid until Delete() is called.
+func (decoded *DecodedDrawData) DrawData() DrawData {
+	return DrawData(decoded.handle)
+}
+
+// Delete releases the decoded draw data.
+func (decoded *DecodedDrawData) Delete() {
+	if decoded.handle != nil {
+		C.iggDrawDataDelete(decoded.handle)
+		decoded.handle = nil
+	}
+}
+
+// DecodeDrawData reads draw data written by DrawData.Encode(). It returns io.EOF if r has no more data.
+func DecodeDrawData(r io.Reader) (*DecodedDrawData, error) {
+	content, err := readDrawDataBinary(r)
+	if err != nil {
+		return nil, err
+	}
+	return content.build()
+}
+
+// DecodeDrawDataJSON reads draw data written by DrawData.EncodeJSON().
+func DecodeDrawDataJSON(r io.Reader) (*DecodedDrawData, error) {
+	var encoded drawDataJSON
+	if err := json.NewDecoder(r).Decode(&encoded); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDrawData, err)
+	}
+	if (encoded.Version == 0) || (encoded.Version > DrawDataEncodingVersion) {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedDrawDataVersion, encoded.Version)
+	}
+	return encoded.toContent().build()
+}
+
+// vertexLayout describes the memory layout of a vertex, see VertexBufferLayout().
+type vertexLayout struct {
+	size, pos, uv, col int
+}
+
+func currentVertexLayout() vertexLayout {
+	var layout vertexLayout
+	layout.size, layout.pos, layout.uv, layout.col = VertexBufferLayout()
+	return layout
+}
+
+// valid reports whether all fields fit into the vertex.
+func (layout vertexLayout) valid() bool {
+	fits := func(offset, size int) bool {
+		return (offset >= 0) && (offset+size <= layout.size)
+	}
+	return fits(layout.pos, 8) && fits(layout.uv, 8) && fits(layout.col, 4)
+}
+
+// drawDataContent is a copy of the draw data that is owned by Go code.
+// Vertices and indices are kept in the layout they were captured or decoded with.
+type drawDataContent struct {
+	valid            bool
+	displayPos       Vec2
+	displaySize      Vec2
+	frameBufferScale Vec2
+	layout           vertexLayout
+	indexSize        int
+	lists            []drawListContent
+}
+
+type drawListContent struct {
+	vertices []byte
+	indices  []byte
+	commands []drawCommandContent
+}
+
+type drawCommandContent struct {
+	elementCount     uint32
+	indexOffset      uint32
+	vertexOffset     uint32
+	clipRect         Vec4
+	textureID        TextureID
+	resetRenderState bool
+}
+
+func captureDrawData(data DrawData) drawDataContent {
+	content := drawDataContent{
+		layout:    currentVertexLayout(),
+		indexSize: IndexBufferLayout(),
+	}
+	if !data.Valid() {
+		return content
+	}
+	content.valid = true
+	content.displayPos = data.DisplayPos()
+	content.displaySize = data.DisplaySize()
+	content.frameBufferScale = data.FrameBufferScale()
+	for _, list := range data.CommandLists() {
+		var listContent drawListContent
+		if ptr, size := list.VertexBuffer(); size > 0 {
+			listContent.vertices = bytes.Clone(unsafe.Slice((*byte)(ptr), size))
+		}
+		if ptr, size := list.IndexBuffer(); size > 0 {
+			listContent.indices = bytes.Clone(unsafe.Slice((*byte)(ptr), size))
+		}
+		for _, cmd := range list.Commands() {
+			resetRenderState := cmd.IsResetRenderState()
+			if cmd.HasUserCallback() && !resetRenderState {
+				continue
+			}
+			listContent.commands = append(listContent.commands, drawCommandContent{
+				elementCount:     uint32(cmd.ElementCount()),
+				indexOffset:      uint32(cmd.IndexOffset()),
+				vertexOffset:     uint32(cmd.VertexOffset()),
+				clipRect:         cmd.ClipRect(),
+				textureID:        cmd.TextureID(),
+				resetRenderState: resetRenderState,
+			})
+		}
+		content.lists = append(content.lists, listContent)
+	}
+	return content
+}
+
+// build converts the content to the current layouts, checks it, and creates the draw data.
+func (content drawDataContent) build() (*DecodedDrawData, error) {
+	layout := currentVertexLayout()
+	indexSize := IndexBufferLayout()
+	lists := make([]drawListContent, len(content.lists))
+	for i, list := range content.lists {
+		vertexCount := len(list.vertices) / content.layout.size
+		indexCount := len(list.indices) / content.indexSize
+		vertices := convertVertices(list.vertices, content.layout, layout)
+		indices, err := convertIndices(list.indices, content.indexSize, indexSize)
+		if err != nil {
+			return nil, fmt.Errorf("%w: list %d: %v", ErrInvalidDrawData, i, err)
+		}
+		for j, cmd := range list.commands {
+			if err := cmd.check(indices, indexSize, indexCount, vertexCount); err != nil {
+				return nil, fmt.Errorf("%w: list %d, command %d: %v", ErrInvalidDrawData, i, j, err)
+			}
+		}
+		lists[i] = drawListContent{vertices: vertices, indices: indices, commands: list.commands}
+	}
+
+	displayPosArg, _ := content.displayPos.wrapped()
+	displaySizeArg, _ := content.displaySize.wrapped()
+	frameBufferScaleArg, _ := content.frameBufferScale.wrapped()
+	decoded := &DecodedDrawData{
+		handle: C.iggDrawDataNew(castBool(content.valid), displayPosArg, displaySizeArg, frameBufferScaleArg),
+	}
+	for _, list := range lists {
+		var vertices, indices unsafe.Pointer
+		if len(list.vertices) > 0 {
+			vertices = unsafe.Pointer(&list.vertices[0])
+		}
+		if len(list.indices) > 0 {
+			indices = unsafe.Pointer(&list.indices[0])
+		}
+		listHandle := C.iggDrawDataAddNewList(decoded.handle,
+			vertices, C.int(len(list.vertices)/layout.size), indices, C.int(len(list.indices)/indexSize))
+		for _, cmd := range list.commands {
+			clipRectArg, _ := cmd.clipRect.wrapped()
+			C.iggDrawDataListAddCommand(listHandle, C.uint(cmd.elementCount), C.uint(cmd.indexOffset), C.uint(cmd.vertexOffset),
+				clipRectArg, cmd.textureID.handle(), castBool(cmd.resetRenderState))
+		}
+	}
+	return decoded, nil
+}
+
+// check verifies that the command only refers to existing indices and vertices,
+// so that a renderer can't read outside the buffers.
+func (cmd drawCommandContent) check(indices []byte, indexSize, indexCount, vertexCount int) error {
+	if cmd.resetRenderState {
+		return nil
+	}
+	if uint64(cmd.indexOffset)+uint64(cmd.elementCount) > uint64(indexCount) {
+		return fmt.Errorf("indices %d to %d out of range", cmd.indexOffset, uint64(cmd.indexOffset)+uint64(cmd.elementCount))
+	}
+	for i := cmd.indexOffset; i < cmd.indexOffset+cmd.elementCount; i++ {
+		if index := uint64(readIndex(indices, indexSize, int(i))) + uint64(cmd.vertexOffset); index >= uint64(vertexCount) {
+			return fmt.Errorf("vertex %d out of range", index)
+		}
+	}
+	return nil
+}
+
+func readIndex(indices []byte, indexSize int, i int) uint32 {
+	if indexSize == 2 {
+		return uint32(binary.NativeEndian.Uint16(indices[i*2:]))
+	}
+	return binary.NativeEndian.Uint32(indices[i*4:])
+}
+
+// convertVertices copies the vertices from one layout into another.
+func convertVertices(vertices []byte, from, to vertexLayout) []byte {
+	if from == to {
+		return vertices
+	}
+	count := len(vertices) / from.size
+	converted := make([]byte, count*to.size)
+	for i := 0; i < count; i++ {
+		src := vertices[i*from.size:]
+		dst := converted[i*to.size:]
+		copy(dst[to.pos:to.pos+8], src[from.pos:from.pos+8])
+		copy(dst[to.uv:to.uv+8], src[from.uv:from.uv+8])
+		copy(dst[to.col:to.col+4], src[from.col:from.col+4])
+	}
+	return converted
+}
+
+// convertIndices copies the indices from one size into another.
+func convertIndices(indices []byte, from, to int) ([]byte, error) {
+	if from == to {
+		return indices, nil
+	}
+	count := len(indices) / from
+	converted := make([]byte, count*to)
+	for i := 0; i < count; i++ {
+		index := readIndex(indices, from, i)
+		if to == 4 {
+			binary.NativeEndian.PutUint32(converted[i*4:], index)
+			continue
+		}
+		if index > math.MaxUint16 {
+			return nil, fmt.Errorf("index %d too large for 16 bit indices", index)
+		}
+		binary.NativeEndian.PutUint16(converted[i*2:], uint16(index))
+	}
+	return converted, nil
+}
+
+// The binary encoding is little endian:
+//
+//	magic, version, valid as bytes
+//	vertex size, position offset, UV offset, color offset, index size as uint32
+//	display position, display size, frame buffer scale as float32 pairs
+//	list count as uint32, and for every list:
+//	  vertex count as uint32, and the vertices with their fields in little endian
+//	  index count as uint32, and the indices in little endian
+//	  command count as uint32, and for every command:
+//	    element count, index offset, vertex offset as uint32, texture ID as uint64,
+//	    clip rectangle as 4 float32, flags as byte (1: reset render state)
+
+const drawCommandFlagResetRenderState = 1
+
+func (content drawDataContent) writeBinary(w *bufio.Writer) {
+	putUint32 := func(value uint32) {
+		_ = binary.Write(w, binary.LittleEndian, value)
+	}
+	putFloat := func(value float32) {
+		putUint32(math.Float32bits(value))
+	}
+
+	w.WriteString(DrawDataMagic)
+	w.WriteByte(DrawDataEncodingVersion)
+	w.WriteByte(byte(castBool(content.valid)))
+	for _, value := range []int{content.layout.size, content.layout.pos, content.layout.uv, content.layout.col, content.indexSize} {
+		putUint32(uint32(value))
+	}
+	for _, value := range []Vec2{content.displayPos, content.displaySize, content.frameBufferScale} {
+		putFloat(value.X)
+		putFloat(value.Y)
+	}
+	putUint32(uint32(len(content.lists)))
+	for _, list := range content.lists {
+		putUint32(uint32(len(list.vertices) / content.layout.size))
+		w.Write(verticesToLittleEndian(list.vertices, content.layout))
+		putUint32(uint32(len(list.indices) / content.indexSize))
+		w.Write(indicesToLittleEndian(list.indices, content.indexSize))
+		putUint32(uint32(len(list.commands)))
+		for _, cmd := range list.commands {
+			putUint32(cmd.elementCount)
+			putUint32(cmd.indexOffset)
+			putUint32(cmd.vertexOffset)
+			_ = binary.Write(w, binary.LittleEndian, uint64(cmd.textureID))
+			putFloat(cmd.clipRect.X)
+			putFloat(cmd.clipRect.Y)
+			putFloat(cmd.clipRect.Z)
+			putFloat(cmd.clipRect.W)
+			var flags byte
+			if cmd.resetRenderState {
+				flags |= drawCommandFlagResetRenderState
+			}
+			w.WriteByte(flags)
+		}
+	}
+}
+
+// verticesToLittleEndian converts the fields of the vertices between native and little endian byte order.
+// On little endian machines, it returns the vertices unchanged. The conversion is its own inverse.
+func verticesToLittleEndian(vertices []byte, layout vertexLayout) []byte {
+	if !byteOrderSwap {
+		return vertices
+	}
+	swapped := bytes.Clone(vertices)
+	for i := 0; i+layout.size <= len(swapped); i += layout.size {
+		for _, offset := range []int{layout.pos, layout.pos + 4, layout.uv, layout.uv + 4, layout.col} {
+			swapBytes(swapped[i+offset : i+offset+4])
+		}
+	}
+	return swapped
+}
+
+// indicesToLittleEndian converts the indices between native and little endian byte order.
+func indicesToLittleEndian(indices []byte, indexSize int) []byte {
+	if !byteOrderSwap {
+		return indices
+	}
+	swapped := bytes.Clone(indices)
+	for i := 0; i+indexSize <= len(swapped); i += indexSize {
+		swapBytes(swapped[i : i+indexSize])
+	}
+	return swapped
+}
+
+var byteOrderSwap = binary.NativeEndian.Uint16([]byte{1, 0}) != 1
+
+func swapBytes(value []byte) {
+	for i, j := 0, len(value)-1; i < j; i, j = i+1, j-1 {
+		value[i], value[j] = value[j], value[i]
+	}
+}
+
+// maxDrawDataBufferSize limits the size of a single buffer that is read, to reject corrupt counts
+// before anything is allocated for them.
+const maxDrawDataBufferSize = 1 << 30
+
+func readDrawDataBinary(r io.Reader) (drawDataContent, error) {
+	var content drawDataContent
+	var header [len(DrawDataMagic) + 2]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return content, err
+		}
+		return content, fmt.Errorf("%w: %v", ErrInvalidDrawData, err)
+	}
+	if string(header[:len(DrawDataMagic)]) != DrawDataMagic {
+		return content, fmt.Errorf("%w: bad signature", ErrInvalidDrawData)
+	}
+	if version := header[len(DrawDataMagic)]; (version == 0) || (version > DrawDataEncodingVersion) {
+		return content, fmt.Errorf("%w: %d", ErrUnsupportedDrawDataVersion, version)
+	}
+	content.valid = header[len(DrawDataMagic)+1] != 0
+
+	reader := drawDataReader{r: r}
+	content.layout = vertexLayout{
+		size: int(reader.uint32()), pos: int(reader.uint32()), uv: int(reader.uint32()), col: int(reader.uint32()),
+	}
+	content.indexSize = int(reader.uint32())
+	if (reader.err == nil) && (!content.layout.valid() || ((content.indexSize != 2) && (content.indexSize != 4))) {
+		return content, fmt.Errorf("%w: bad buffer layout", ErrInvalidDrawData)
+	}
+	content.displayPos = reader.vec2()
+	content.displaySize = reader.vec2()
+	content.frameBufferScale = reader.vec2()
+	listCount := reader.uint32()
+	for i := uint32(0); (i < listCount) && (reader.err == nil); i++ {
+		var list drawListContent
+		list.vertices = reader.buffer(reader.uint32(), content.layout.size)
+		list.indices = reader.buffer(reader.uint32(), content.indexSize)
+		if byteOrderSwap {
+			list.vertices = verticesToLittleEndian(list.vertices, content.layout)
+			list.indices = indicesToLittleEndian(list.indices, content.indexSize)
+		}
+		commandCount := reader.uint32()
+		for j := uint32(0); (j < commandCount) && (reader.err == nil); j++ {
+			cmd := drawCommandContent{
+				elementCount: reader.uint32(),
+				indexOffset:  reader.uint32(),
+				vertexOffset: reader.uint32(),
+				textureID:    TextureID(reader.uint64()),
+				clipRect:     Vec4{X: reader.float(), Y: reader.float(), Z: reader.float(), W: reader.float()},
+			}
+			cmd.resetRenderState = reader.byte()&drawCommandFlagResetRenderState != 0
+			list.commands = append(list.commands, cmd)
+		}
+		content.lists = append(content.lists, list)
+	}
+	if reader.err != nil {
+		err := reader.err
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		return content, fmt.Errorf("%w: %v", ErrInvalidDrawData, err)
+	}
+	return content, nil
+}
+
+// drawDataReader reads little endian values, and keeps the first error.
+type drawDataReader struct {
+	r   io.Reader
+	err error
+}
+
+func (reader *drawDataReader) read(size int) []byte {
+	if reader.err != nil {
+		return make([]byte, size)
+	}
+	data := make([]byte, size)
+	_, reader.err = io.ReadFull(reader.r, data)
+	return data
+}
+
+func (reader *drawDataReader) byte() byte {
+	return reader.read(1)[0]
+}
+
+func (reader *drawDataReader) uint32() uint32 {
+	return binary.LittleEndian.Uint32(reader.read(4))
+}
+
+func (reader *drawDataReader) uint64() uint64 {
+	return binary.LittleEndian.Uint64(reader.read(8))
+}
+
+func (reader *drawDataReader) float() float32 {
+	return math.Float32frombits(reader.uint32())
+}
+
+func (reader *drawDataReader) vec2() Vec2 {
+	return Vec2{X: reader.float(), Y: reader.float()}
+}
+
+// buffer reads count entries of the given size. The buffer grows as data arrives,
+// so that a corrupt count fails at the end of the data instead of allocating it up front.
+func (reader *drawDataReader) buffer(count uint32, entrySize int) []byte {
+	size := uint64(count) * uint64(entrySize)
+	if reader.err != nil {
+		return nil
+	}
+	if size > maxDrawDataBufferSize {
+		reader.err = fmt.Errorf("buffer of %d bytes too large", size)
+		return nil
+	}
+	var buf bytes.Buffer
+	if _, err := io.CopyN(&buf, reader.r, int64(size)); err != nil {
+		reader.err = err
+		return nil
+	}
+	return buf.Bytes()
+}
+
+// drawDataJSON is the JSON encoding. It spells out the fields of every vertex.
+type drawDataJSON struct {
+	Version          int            `json:"version"`
+	Valid            bool           `json:"valid"`
+	DisplayPos       Vec2           `json:"displayPos"`
+	DisplaySize      Vec2           `json:"displaySize"`
+	FrameBufferScale Vec2           `json:"frameBufferScale"`
+	Lists            []drawListJSON `json:"lists"`
+}
+
+type drawListJSON struct {
+	Vertices []drawVertexJSON  `json:"vertices"`
+	Indices  []uint32          `json:"indices"`
+	Commands []drawCommandJSON `json:"commands"`
+}
+
+type drawVertexJSON struct {
+	Pos Vec2        `json:"pos"`
+	UV  Vec2        `json:"uv"`
+	Col PackedColor `json:"col"`
+}
+
+type drawCommandJSON struct {
+	ElementCount     uint32    `json:"elementCount"`
+	IndexOffset      uint32    `json:"indexOffset"`
+	VertexOffset     uint32    `json:"vertexOffset"`
+	ClipRect         Vec4      `json:"clipRect"`
+	TextureID        TextureID `json:"textureID"`
+	ResetRenderState bool      `json:"resetRenderState,omitempty"`
+}
+
+func (content drawDataContent) toJSON() drawDataJSON {
+	encoded := drawDataJSON{
+		Version:          DrawDataEncodingVersion,
+		Valid:            content.valid,
+		DisplayPos:       content.displayPos,
+		DisplaySize:      content.displaySize,
+		FrameBufferScale: content.frameBufferScale,
+		Lists:            []drawListJSON{},
+	}
+	layout := content.layout
+	for _, list := range content.lists {
+		listJSON := drawListJSON{
+			Vertices: make([]drawVertexJSON, len(list.vertices)/layout.size),
+			Indices:  make([]uint32, len(list.indices)/content.indexSize),
+			Commands: make([]drawCommandJSON, len(list.commands)),
+		}
+		for i := range listJSON.Vertices {
+			vertex := list.vertices[i*layout.size:]
+			listJSON.Vertices[i] = drawVertexJSON{
+				Pos: Vec2{X: nativeFloat(vertex[layout.pos:]), Y: nativeFloat(vertex[layout.pos+4:])},
+				UV:  Vec2{X: nativeFloat(vertex[layout.uv:]), Y: nativeFloat(vertex[layout.uv+4:])},
+				Col: PackedColor(binary.NativeEndian.Uint32(vertex[layout.col:])),
+			}
+		}
+		for i := range listJSON.Indices {
+			listJSON.Indices[i] = readIndex(list.indices, content.indexSize, i)
+		}
+		for i, cmd := range list.commands {
+			listJSON.Commands[i] = drawCommandJSON{
+				ElementCount:     cmd.elementCount,
+				IndexOffset:      cmd.indexOffset,
+				VertexOffset:     cmd.vertexOffset,
+				ClipRect:         cmd.clipRect,
+				TextureID:        cmd.textureID,
+				ResetRenderState: cmd.resetRenderState,
+			}
+		}
+		encoded.Lists = append(encoded.Lists, listJSON)
+	}
+	return encoded
+}
+
+// toContent converts the JSON encoding to content with 32 bit indices; build() converts them as needed.
+func (encoded drawDataJSON) toContent() drawDataContent {
+	layout := currentVertexLayout()
+	content := drawDataContent{
+		valid:            encoded.Valid,
+		displayPos:       encoded.DisplayPos,
+		displaySize:      encoded.DisplaySize,
+		frameBufferScale: encoded.FrameBufferScale,
+		layout:           layout,
+		indexSize:        4,
+	}
+	for _, listJSON := range encoded.Lists {
+		list := drawListContent{
+			vertices: make([]byte, len(listJSON.Vertices)*layout.size),
+			indices:  make([]byte, len(listJSON.Indices)*4),
+		}
+		for i, vertexJSON := range listJSON.Vertices {
+			vertex := list.vertices[i*layout.size:]
+			putNativeFloat(vertex[layout.pos:], vertexJSON.Pos.X)
+			putNativeFloat(vertex[layout.pos+4:], vertexJSON.Pos.Y)
+			putNativeFloat(vertex[layout.uv:], vertexJSON.UV.X)
+			putNativeFloat(vertex[layout.uv+4:], vertexJSON.UV.Y)
+			binary.NativeEndian.PutUint32(vertex[layout.col:], uint32(vertexJSON.Col))
+		}
+		for i, index := range listJSON.Indices {
+			binary.NativeEndian.PutUint32(list.indices[i*4:], index)
+		}
+		for _, cmd := range listJSON.Commands {
+			list.commands = append(list.commands, drawCommandContent{
+				elementCount:     cmd.ElementCount,
+				indexOffset:      cmd.IndexOffset,
+				vertexOffset:     cmd.VertexOffset,
+				clipRect:         cmd.ClipRect,
+				textureID:        cmd.TextureID,
+				resetRenderState: cmd.ResetRenderState,
+			})
+		}
+		content.lists = append(content.lists, list)
+	}
+	return content
+}
+
+func nativeFloat(data []byte) float32 {
+	return math.Float32frombits(binary.NativeEndian.Uint32(data))
+}
+
+func putNativeFloat(data []byte, value float32) {
+	binary.NativeEndian.PutUint32(data, math.Float32bits(value))
+}
@@ -0,0 +1,145 @@
+package imgui_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+	"github.com/jetsetilly/imgui-go/v5/softrender"
+)
+
+func renderDemoFrame(t *testing.T) {
+	t.Helper()
+	imgui.CurrentIO().SetDisplaySize(imgui.Vec2{X: 400, Y: 300})
+	for i := 0; i < 2; i++ {
+		imgui.NewFrame()
+		imgui.ShowDemoWindow(nil)
+		imgui.Render()
+	}
+	require.True(t, imgui.RenderedDrawData().Valid())
+}
+
+func drawListBytes(list imgui.DrawList) (vertices, indices []byte) {
+	if ptr, size := list.VertexBuffer(); size > 0 {
+		vertices = append(vertices, unsafe.Slice((*byte)(ptr), size)...)
+	}
+	if ptr, size := list.IndexBuffer(); size > 0 {
+		indices = append(indices, unsafe.Slice((*byte)(ptr), size)...)
+	}
+	return
+}
+
+func assertSameDrawData(t *testing.T, expected, actual imgui.DrawData) {
+	t.Helper()
+	assert.Equal(t, expected.Valid(), actual.Valid())
+	assert.Equal(t, expected.DisplayPos(), actual.DisplayPos())
+	assert.Equal(t, expected.DisplaySize(), actual.DisplaySize())
+	assert.Equal(t, expected.FrameBufferScale(), actual.FrameBufferScale())
+	expectedLists, actualLists := expected.CommandLists(), actual.CommandLists()
+	require.Equal(t, len(expectedLists), len(actualLists))
+	for i := range expectedLists {
+		expectedVertices, expectedIndices := drawListBytes(expectedLists[i])
+		actualVertices, actualIndices := drawListBytes(actualLists[i])
+		assert.Equal(t, expectedVertices, actualVertices, "vertices of list %d", i)
+		assert.Equal(t, expectedIndices, actualIndices, "indices of list %d", i)
+		expectedCommands, actualCommands := expectedLists[i].Commands(), actualLists[i].Commands()
+		require.Equal(t, len(expectedCommands), len(actualCommands), "commands of list %d", i)
+		for j := range expectedCommands {
+			assert.Equal(t, expectedCommands[j].ElementCount(), actualCommands[j].ElementCount())
+			assert.Equal(t, expectedCommands[j].IndexOffset(), actualCommands[j].IndexOffset())
+			assert.Equal(t, expectedCommands[j].VertexOffset(), actualCommands[j].VertexOffset())
+			assert.Equal(t, expectedCommands[j].ClipRect(), actualCommands[j].ClipRect())
+			assert.Equal(t, expectedCommands[j].TextureID(), actualCommands[j].TextureID())
+		}
+	}
+}
+
+func TestDrawDataEncodeDecode(t *testing.T) {
+	newTestContext(t)
+	renderDemoFrame(t)
+	live := imgui.RenderedDrawData()
+
+	var encoded bytes.Buffer
+	require.NoError(t, live.Encode(&encoded))
+	assert.Equal(t, imgui.DrawDataMagic, encoded.String()[:4])
+	decoded, err := imgui.DecodeDrawData(&encoded)
+	require.NoError(t, err)
+	defer decoded.Delete()
+	assertSameDrawData(t, live, decoded.DrawData())
+
+	var encodedJSON bytes.Buffer
+	require.NoError(t, live.EncodeJSON(&encodedJSON))
+	decodedJSON, err := imgui.DecodeDrawDataJSON(&encodedJSON)
+	require.NoError(t, err)
+	defer decodedJSON.Delete()
+	assertSameDrawData(t, live, decodedJSON.DrawData())
+
+	renderer := softrender.NewRenderer()
+	renderer.RegisterFontAtlas(imgui.CurrentIO().Fonts())
+	assert.Equal(t, renderer.Render(live).Pix, renderer.Render(decoded.DrawData()).Pix,
+		"decoded frame should render like the live one")
+}
+
+func TestDrawDataRecordsSeveralFrames(t *testing.T) {
+	context := newTestContext(t)
+	renderDemoFrame(t)
+
+	var recording bytes.Buffer
+	require.NoError(t, imgui.RenderedDrawData().Encode(&recording))
+	imgui.NewFrame()
+	imgui.Render()
+	require.NoError(t, imgui.RenderedDrawData().Encode(&recording))
+	context.Destroy()
+
+	first, err := imgui.DecodeDrawData(&recording)
+	require.NoError(t, err)
+	defer first.Delete()
+	second, err := imgui.DecodeDrawData(&recording)
+	require.NoError(t, err)
+	defer second.Delete()
+	_, err = imgui.DecodeDrawData(&recording)
+	assert.Equal(t, io.EOF, err)
+
+	assert.True(t, len(first.DrawData().CommandLists()) > len(second.DrawData().CommandLists()),
+		"frames should be decoded in order")
+	assert.Equal(t, imgui.Vec2{X: 400, Y: 300}, first.DrawData().DisplaySize(), "decoding should not need a context")
+}
+
+func TestDrawDataDecodeRejectsBadData(t *testing.T) {
+	newTestContext(t)
+	renderDemoFrame(t)
+	var encoded bytes.Buffer
+	require.NoError(t, imgui.RenderedDrawData().Encode(&encoded))
+	valid := encoded.Bytes()
+
+	_, err := imgui.DecodeDrawData(bytes.NewReader([]byte("nope")))
+	assert.True(t, errors.Is(err, imgui.ErrInvalidDrawData))
+
+	_, err = imgui.DecodeDrawData(bytes.NewReader(valid[:len(valid)/2]))
+	assert.True(t, errors.Is(err, imgui.ErrInvalidDrawData), "truncated data should be rejected")
+
+	newer := bytes.Clone(valid)
+	newer[4] = imgui.DrawDataEncodingVersion + 1
+	_, err = imgui.DecodeDrawData(bytes.NewReader(newer))
+	assert.True(t, errors.Is(err, imgui.ErrUnsupportedDrawDataVersion))
+
+	// the first command of the first list starts after the vertices and indices of that list.
+	corrupt := bytes.Clone(valid)
+	vertexSize := int(binary.LittleEndian.Uint32(corrupt[6:]))
+	indexSize := int(binary.LittleEndian.Uint32(corrupt[22:]))
+	pos := 6 + 5*4 + 6*4 + 4
+	vertexCount := int(binary.LittleEndian.Uint32(corrupt[pos:]))
+	pos += 4 + vertexCount*vertexSize
+	indexCount := int(binary.LittleEndian.Uint32(corrupt[pos:]))
+	pos += 4 + indexCount*indexSize + 4
+	binary.LittleEndian.PutUint32(corrupt[pos:], uint32(indexCount+1))
+	_, err = imgui.DecodeDrawData(bytes.NewReader(corrupt))
+	assert.True(t, errors.Is(err, imgui.ErrInvalidDrawData), "commands outside the buffers should be rejected")
+}
@@ -13,6 +13,7 @@ func TestDrawData(t *testing.T) {
 	defer context.Destroy()
 
 	io := imgui.CurrentIO()
+	io.SetIniFilename("")
 	io.SetDisplaySize(imgui.Vec2{X: 800, Y: 600})
 	io.Fonts().TextureDataAlpha8()
 
@@ -0,0 +1,10 @@
+//go:build !imguidrawidx32
+// +build !imguidrawidx32
+
+package imgui
+
+// DrawIdx is an index into the vertices of a DrawList. Its size is the one of the entries of the index buffer,
+// see IndexBufferLayout().
+//
+// Indices have 16 bits by default. Build with the tag imguidrawidx32 to use 32-bit indices.
+type DrawIdx uint16
@@ -0,0 +1,14 @@
+//go:build imguidrawidx32
+// +build imguidrawidx32
+
+package imgui
+
+// #cgo CPPFLAGS: -DImDrawIdx=ImU32
+import "C"
+
+// DrawIdx is an index into the vertices of a DrawList. Its size is the one of the entries of the index buffer,
+// see IndexBufferLayout().
+//
+// The tag imguidrawidx32 configures Dear ImGui to use 32-bit indices, which allows more than 64K vertices
+// within a DrawList without setting BackendFlagsRendererHasVtxOffset.
+type DrawIdx uint32
@@ -3,7 +3,10 @@ package imgui
 // #include "wrapper/DrawList.h"
 import "C"
 import (
+	"fmt"
 	"image/color"
+	"reflect"
+	"runtime/cgo"
 	"unsafe"
 )
 
@@ -87,6 +90,77 @@ func (list DrawList) IndexBuffer() (unsafe.Pointer, int) {
 	return data, int(size)
 }
 
+// ChannelsSplit splits the list into count channels, which can be drawn into out of order.
+// Channel 0 is drawn first, and becomes the current channel. ChannelsMerge() must be called
+// before the list is used by ImGui again, such as before End() of the window.
+//
+// Splits can not be nested; use a DrawListSplitter within a split of the list.
+func (list DrawList) ChannelsSplit(count int) {
+	C.iggDrawListChannelsSplit(list.handle(), C.int(count))
+}
+
+// ChannelsSetCurrent makes the channel of the given index the target of the following primitives.
+func (list DrawList) ChannelsSetCurrent(index int) {
+	C.iggDrawListChannelsSetCurrent(list.handle(), C.int(index))
+}
+
+// ChannelsMerge joins the channels back into the list, in order of their index.
+func (list DrawList) ChannelsMerge() {
+	C.iggDrawListChannelsMerge(list.handle())
+}
+
+// DrawVert is a vertex of a DrawList. Its memory layout is the one of the vertex buffer,
+// see VertexBufferLayout().
+type DrawVert struct {
+	Pos Vec2
+	UV  Vec2
+	Col PackedColor
+}
+
+func init() {
+	if err := checkVertexLayout(); err != nil {
+		panic(err)
+	}
+}
+
+// checkVertexLayout verifies that DrawVert has the layout of the vertex buffer, which Vertices() relies on.
+// The size of DrawIdx is selected by build tag, see DrawIdx.
+func checkVertexLayout() error {
+	var vertex DrawVert
+	entrySize, posOffset, uvOffset, colOffset := VertexBufferLayout()
+	if (entrySize != int(unsafe.Sizeof(vertex))) || (posOffset != int(unsafe.Offsetof(vertex.Pos))) ||
+		(uvOffset != int(unsafe.Offsetof(vertex.UV))) || (colOffset != int(unsafe.Offsetof(vertex.Col))) {
+		return fmt.Errorf("imgui: layout of DrawVert (size %d, pos %d, uv %d, col %d) does not match ImDrawVert (size %d, pos %d, uv %d, col %d)",
+			unsafe.Sizeof(vertex), unsafe.Offsetof(vertex.Pos), unsafe.Offsetof(vertex.UV), unsafe.Offsetof(vertex.Col),
+			entrySize, posOffset, uvOffset, colOffset)
+	}
+	return nil
+}
+
+// Vertices returns the vertex buffer of the list.
+//
+// The slice refers to the memory of the list, without a copy. It is only valid until the list is changed,
+// which is at the latest with the next frame; it must not be appended to.
+func (list DrawList) Vertices() []DrawVert {
+	data, size := list.VertexBuffer()
+	if size == 0 {
+		return nil
+	}
+	return unsafe.Slice((*DrawVert)(data), size/int(unsafe.Sizeof(DrawVert{})))
+}
+
+// Indices returns the index buffer of the list. The indices of a DrawCommand start at its IndexOffset(),
+// and refer to the vertices starting at its VertexOffset().
+//
+// The slice refers to the memory of the list, without a copy, with the same restrictions as for Vertices().
+func (list DrawList) Indices() []DrawIdx {
+	data, size := list.IndexBuffer()
+	if size == 0 {
+		return nil
+	}
+	return unsafe.Slice((*DrawIdx)(data), size/int(unsafe.Sizeof(DrawIdx(0))))
+}
+
 // WindowDrawList returns the DrawList for the current window.
 func WindowDrawList() DrawList {
 	return DrawList(C.iggGetWindowDrawList())
@@ -253,6 +327,132 @@ func (list DrawList) AddTriangleFilled(p1 Vec2, p2 Vec2, p3 Vec2, col PackedColo
 	C.iggAddTriangleFilled(list.handle(), p1Arg, p2Arg, p3Arg, C.IggPackedColor(col))
 }
 
+// AddQuad calls AddQuadV(p1, p2, p3, p4, col, 1.0).
+func (list DrawList) AddQuad(p1 Vec2, p2 Vec2, p3 Vec2, p4 Vec2, col PackedColor) {
+	list.AddQuadV(p1, p2, p3, p4, col, 1.0)
+}
+
+// AddQuadV adds an unfilled quad of points p1, p2, p3, p4 to the draw list.
+func (list DrawList) AddQuadV(p1 Vec2, p2 Vec2, p3 Vec2, p4 Vec2, col PackedColor, thickness float32) {
+	p1Arg, _ := p1.wrapped()
+	p2Arg, _ := p2.wrapped()
+	p3Arg, _ := p3.wrapped()
+	p4Arg, _ := p4.wrapped()
+	C.iggAddQuad(list.handle(), p1Arg, p2Arg, p3Arg, p4Arg, C.IggPackedColor(col), C.float(thickness))
+}
+
+// AddQuadFilled adds a filled quad of points p1, p2, p3, p4 to the draw list.
+// The quad must be convex.
+func (list DrawList) AddQuadFilled(p1 Vec2, p2 Vec2, p3 Vec2, p4 Vec2, col PackedColor) {
+	p1Arg, _ := p1.wrapped()
+	p2Arg, _ := p2.wrapped()
+	p3Arg, _ := p3.wrapped()
+	p4Arg, _ := p4.wrapped()
+	C.iggAddQuadFilled(list.handle(), p1Arg, p2Arg, p3Arg, p4Arg, C.IggPackedColor(col))
+}
+
+// AddNgon calls AddNgonV(center, radius, col, numSegments, 1.0).
+func (list DrawList) AddNgon(center Vec2, radius float32, col PackedColor, numSegments int) {
+	list.AddNgonV(center, radius, col, numSegments, 1.0)
+}
+
+// AddNgonV adds an unfilled regular polygon with the given number of sides to the draw list.
+// Unlike AddCircleV(), the number of sides is exact.
+func (list DrawList) AddNgonV(center Vec2, radius float32, col PackedColor, numSegments int, thickness float32) {
+	centerArg, _ := center.wrapped()
+	C.iggAddNgon(list.handle(), centerArg, C.float(radius), C.IggPackedColor(col), C.int(numSegments), C.float(thickness))
+}
+
+// AddNgonFilled adds a filled regular polygon with the given number of sides to the draw list.
+func (list DrawList) AddNgonFilled(center Vec2, radius float32, col PackedColor, numSegments int) {
+	centerArg, _ := center.wrapped()
+	C.iggAddNgonFilled(list.handle(), centerArg, C.float(radius), C.IggPackedColor(col), C.int(numSegments))
+}
+
+// AddEllipse calls AddEllipseV(center, radius, col, 0, 0, 1.0).
+func (list DrawList) AddEllipse(center Vec2, radius Vec2, col PackedColor) {
+	list.AddEllipseV(center, radius, col, 0, 0, 1.0)
+}
+
+// AddEllipseV adds an unfilled ellipse to the draw list. radius holds the horizontal and vertical radius,
+// before the ellipse is rotated by rot radians. A numSegments of 0 chooses the number of segments automatically.
+func (list DrawList) AddEllipseV(center Vec2, radius Vec2, col PackedColor, rot float32, numSegments int, thickness float32) {
+	centerArg, _ := center.wrapped()
+	radiusArg, _ := radius.wrapped()
+	C.iggAddEllipse(list.handle(), centerArg, radiusArg, C.IggPackedColor(col), C.float(rot), C.int(numSegments), C.float(thickness))
+}
+
+// AddEllipseFilled calls AddEllipseFilledV(center, radius, col, 0, 0).
+func (list DrawList) AddEllipseFilled(center Vec2, radius Vec2, col PackedColor) {
+	list.AddEllipseFilledV(center, radius, col, 0, 0)
+}
+
+// AddEllipseFilledV adds a filled ellipse to the draw list. See AddEllipseV() for the parameters.
+func (list DrawList) AddEllipseFilledV(center Vec2, radius Vec2, col PackedColor, rot float32, numSegments int) {
+	centerArg, _ := center.wrapped()
+	radiusArg, _ := radius.wrapped()
+	C.iggAddEllipseFilled(list.handle(), centerArg, radiusArg, C.IggPackedColor(col), C.float(rot), C.int(numSegments))
+}
+
+// AddBezierCubic calls AddBezierCubicV(p1, p2, p3, p4, col, thickness, 0).
+func (list DrawList) AddBezierCubic(p1 Vec2, p2 Vec2, p3 Vec2, p4 Vec2, col PackedColor, thickness float32) {
+	list.AddBezierCubicV(p1, p2, p3, p4, col, thickness, 0)
+}
+
+// AddBezierCubicV adds a cubic Bezier curve from p1 to p4, with the control points p2 and p3, to the draw list.
+// A numSegments of 0 tessellates the curve according to Style.CurveTessellationTol.
+func (list DrawList) AddBezierCubicV(p1 Vec2, p2 Vec2, p3 Vec2, p4 Vec2, col PackedColor, thickness float32, numSegments int) {
+	p1Arg, _ := p1.wrapped()
+	p2Arg, _ := p2.wrapped()
+	p3Arg, _ := p3.wrapped()
+	p4Arg, _ := p4.wrapped()
+	C.iggAddBezierCubic(list.handle(), p1Arg, p2Arg, p3Arg, p4Arg, C.IggPackedColor(col), C.float(thickness), C.int(numSegments))
+}
+
+// AddBezierQuadratic calls AddBezierQuadraticV(p1, p2, p3, col, thickness, 0).
+func (list DrawList) AddBezierQuadratic(p1 Vec2, p2 Vec2, p3 Vec2, col PackedColor, thickness float32) {
+	list.AddBezierQuadraticV(p1, p2, p3, col, thickness, 0)
+}
+
+// AddBezierQuadraticV adds a quadratic Bezier curve from p1 to p3, with the control point p2, to the draw list.
+// A numSegments of 0 tessellates the curve according to Style.CurveTessellationTol.
+func (list DrawList) AddBezierQuadraticV(p1 Vec2, p2 Vec2, p3 Vec2, col PackedColor, thickness float32, numSegments int) {
+	p1Arg, _ := p1.wrapped()
+	p2Arg, _ := p2.wrapped()
+	p3Arg, _ := p3.wrapped()
+	C.iggAddBezierQuadratic(list.handle(), p1Arg, p2Arg, p3Arg, C.IggPackedColor(col), C.float(thickness), C.int(numSegments))
+}
+
+// pointsArg returns the points as an array for C. Vec2 has the layout of IggVec2, so the points
+// are passed as they are, without a copy.
+func pointsArg(points []Vec2) (*C.IggVec2, C.int) {
+	if len(points) == 0 {
+		return nil, 0
+	}
+	return (*C.IggVec2)(unsafe.Pointer(&points[0])), C.int(len(points))
+}
+
+// AddPolyline adds lines through the given points to the draw list.
+// With DrawFlagsClosed, the last point is connected to the first one.
+func (list DrawList) AddPolyline(points []Vec2, col PackedColor, flags DrawFlags, thickness float32) {
+	pointsPtr, count := pointsArg(points)
+	C.iggAddPolyline(list.handle(), pointsPtr, count, C.IggPackedColor(col), C.int(flags), C.float(thickness))
+}
+
+// AddConvexPolyFilled adds a filled convex polygon of the given points to the draw list.
+// Anti-aliasing requires the points to be in clockwise order.
+func (list DrawList) AddConvexPolyFilled(points []Vec2, col PackedColor) {
+	pointsPtr, count := pointsArg(points)
+	C.iggAddConvexPolyFilled(list.handle(), pointsPtr, count, C.IggPackedColor(col))
+}
+
+// AddConcavePolyFilled adds a filled polygon of the given points to the draw list.
+// It is slower than AddConvexPolyFilled(), but the polygon may be concave. It must not intersect itself.
+func (list DrawList) AddConcavePolyFilled(points []Vec2, col PackedColor) {
+	pointsPtr, count := pointsArg(points)
+	C.iggAddConcavePolyFilled(list.handle(), pointsPtr, count, C.IggPackedColor(col))
+}
+
 // AddText adds a text in specified color at given position pos.
 func (list DrawList) AddText(pos Vec2, col PackedColor, text string) {
 	CString := newStringBuffer(text)
@@ -298,6 +498,183 @@ func (list DrawList) AddImageQuadV(textureID TextureID, p1 Vec2, p2 Vec2, p3 Vec
 	C.iggAddImageQuad(list.handle(), C.IggTextureID(textureID), p1Arg, p2Arg, p3Arg, p4Arg, uv1Arg, uv2Arg, uv3Arg, uv4Arg, C.IggPackedColor(tintCol))
 }
 
+// AddImageRounded calls AddImageRoundedV(textureID, posMin, posMax, Vec2{0,0}, Vec2{1,1}, Packed(color.White), rounding, DrawFlagsNone).
+func (list DrawList) AddImageRounded(textureID TextureID, posMin Vec2, posMax Vec2, rounding float32) {
+	list.AddImageRoundedV(textureID, posMin, posMax, Vec2{X: 0, Y: 0}, Vec2{X: 1, Y: 1}, Packed(color.White), rounding, DrawFlagsNone)
+}
+
+// AddImageRoundedV adds an image based on given texture ID, with rounded corners.
+// flags indicate which corners are rounded, as for AddRectV().
+func (list DrawList) AddImageRoundedV(textureID TextureID, posMin Vec2, posMax Vec2, uvMin Vec2, uvMax Vec2, tintCol PackedColor, rounding float32, flags DrawFlags) {
+	posMinArg, _ := posMin.wrapped()
+	posMaxArg, _ := posMax.wrapped()
+	uvMinArg, _ := uvMin.wrapped()
+	uvMaxArg, _ := uvMax.wrapped()
+	C.iggAddImageRounded(list.handle(), C.IggTextureID(textureID), posMinArg, posMaxArg, uvMinArg, uvMaxArg, C.IggPackedColor(tintCol), C.float(rounding), C.int(flags))
+}
+
+// DrawCallback is called by the renderer in place of a draw command, with the list and the command
+// that it was added to. The clip rectangle and the texture ID of the command are set normally.
+type DrawCallback func(list DrawList, cmd DrawCommand)
+
+// ResetRenderState is a special callback for AddCallback(). It requests the renderer to reset
+// its render state to the default, for example after a previous callback changed it.
+// It is never called: renderers check for it with DrawCommand.IsResetRenderState().
+func ResetRenderState(DrawList, DrawCommand) {}
+
+// AddCallback adds a draw command that makes the renderer call the given function, instead of drawing vertices.
+// Passing ResetRenderState adds a request to reset the render state. A nil callback is ignored.
+//
+// The function is kept until the next call to NewFrame() of the current context, or until the context is destroyed.
+// Renderers can call it with DrawCommand.CallUserCallback(), or directly with the function of DrawCommand.Callback().
+// Without a current context, such as for the lists of decoded draw data, a Go function would never be released;
+// it is ignored then.
+func (list DrawList) AddCallback(callback DrawCallback) {
+	if callback == nil {
+		return
+	}
+	if reflect.ValueOf(callback).Pointer() == reflect.ValueOf(ResetRenderState).Pointer() {
+		C.iggAddResetRenderState(list.handle())
+		return
+	}
+	state := currentState()
+	if state == detachedState {
+		return
+	}
+	handle := cgo.NewHandle(callback)
+	state.mutex.Lock()
+	state.drawCallbacks = append(state.drawCallbacks, handle)
+	state.mutex.Unlock()
+	C.iggAddCallback(list.handle(), C.uintptr_t(handle))
+}
+
+//export iggDrawListCallback
+func iggDrawListCallback(callback C.uintptr_t, list C.IggDrawList, cmd C.IggDrawCmd) {
+	cgo.Handle(callback).Value().(DrawCallback)(DrawList(list), DrawCommand(cmd))
+}
+
+// releaseDrawCallbacks releases the functions added with AddCallback(), once their frame is rendered.
+func (state *contextState) releaseDrawCallbacks() {
+	state.mutex.Lock()
+	callbacks := state.drawCallbacks
+	state.drawCallbacks = nil
+	state.mutex.Unlock()
+	for _, handle := range callbacks {
+		handle.Delete()
+	}
+}
+
+// PathClear discards the points of the current path.
+//
+// The path functions build a path from points, which is then added to the draw list
+// with PathStroke(), PathFillConvex() or PathFillConcave(). These also clear the path.
+// For filled shapes, the points should be in clockwise order; arcs are clockwise
+// for increasing angles.
+func (list DrawList) PathClear() {
+	C.iggPathClear(list.handle())
+}
+
+// PathLineTo adds a point to the path.
+func (list DrawList) PathLineTo(pos Vec2) {
+	posArg, _ := pos.wrapped()
+	C.iggPathLineTo(list.handle(), posArg)
+}
+
+// PathFillConvex adds the path as a filled convex polygon, and clears the path.
+func (list DrawList) PathFillConvex(col PackedColor) {
+	C.iggPathFillConvex(list.handle(), C.IggPackedColor(col))
+}
+
+// PathFillConcave adds the path as a filled polygon, which may be concave, and clears the path.
+func (list DrawList) PathFillConcave(col PackedColor) {
+	C.iggPathFillConcave(list.handle(), C.IggPackedColor(col))
+}
+
+// PathStroke calls PathStrokeV(col, DrawFlagsNone, 1.0).
+func (list DrawList) PathStroke(col PackedColor) {
+	list.PathStrokeV(col, DrawFlagsNone, 1.0)
+}
+
+// PathStrokeV adds the path as lines, and clears the path.
+// With DrawFlagsClosed, the last point is connected to the first one.
+func (list DrawList) PathStrokeV(col PackedColor, flags DrawFlags, thickness float32) {
+	C.iggPathStroke(list.handle(), C.IggPackedColor(col), C.int(flags), C.float(thickness))
+}
+
+// PathArcTo calls PathArcToV(center, radius, aMin, aMax, 0).
+func (list DrawList) PathArcTo(center Vec2, radius float32, aMin float32, aMax float32) {
+	list.PathArcToV(center, radius, aMin, aMax, 0)
+}
+
+// PathArcToV adds the points of a circular arc from angle aMin to aMax, in radians, to the path.
+// A numSegments of 0 chooses the number of segments automatically.
+func (list DrawList) PathArcToV(center Vec2, radius float32, aMin float32, aMax float32, numSegments int) {
+	centerArg, _ := center.wrapped()
+	C.iggPathArcTo(list.handle(), centerArg, C.float(radius), C.float(aMin), C.float(aMax), C.int(numSegments))
+}
+
+// PathArcToFast adds the points of a circular arc to the path, using precomputed angles of a 12 step circle.
+// The angles are given in steps: 0 is to the right, 3 is down, 6 is to the left, and 9 is up.
+func (list DrawList) PathArcToFast(center Vec2, radius float32, aMinOf12 int, aMaxOf12 int) {
+	centerArg, _ := center.wrapped()
+	C.iggPathArcToFast(list.handle(), centerArg, C.float(radius), C.int(aMinOf12), C.int(aMaxOf12))
+}
+
+// PathEllipticalArcTo calls PathEllipticalArcToV(center, radius, rot, aMin, aMax, 0).
+func (list DrawList) PathEllipticalArcTo(center Vec2, radius Vec2, rot float32, aMin float32, aMax float32) {
+	list.PathEllipticalArcToV(center, radius, rot, aMin, aMax, 0)
+}
+
+// PathEllipticalArcToV adds the points of an elliptical arc from angle aMin to aMax, in radians, to the path.
+// The ellipse is rotated by rot radians. A numSegments of 0 chooses the number of segments automatically.
+func (list DrawList) PathEllipticalArcToV(center Vec2, radius Vec2, rot float32, aMin float32, aMax float32, numSegments int) {
+	centerArg, _ := center.wrapped()
+	radiusArg, _ := radius.wrapped()
+	C.iggPathEllipticalArcTo(list.handle(), centerArg, radiusArg, C.float(rot), C.float(aMin), C.float(aMax), C.int(numSegments))
+}
+
+// PathBezierCubicCurveTo calls PathBezierCubicCurveToV(p2, p3, p4, 0).
+func (list DrawList) PathBezierCubicCurveTo(p2 Vec2, p3 Vec2, p4 Vec2) {
+	list.PathBezierCubicCurveToV(p2, p3, p4, 0)
+}
+
+// PathBezierCubicCurveToV adds the points of a cubic Bezier curve to the path. The curve starts at the last
+// point of the path, and ends at p4, with the control points p2 and p3.
+// A numSegments of 0 tessellates the curve according to Style.CurveTessellationTol.
+func (list DrawList) PathBezierCubicCurveToV(p2 Vec2, p3 Vec2, p4 Vec2, numSegments int) {
+	p2Arg, _ := p2.wrapped()
+	p3Arg, _ := p3.wrapped()
+	p4Arg, _ := p4.wrapped()
+	C.iggPathBezierCubicCurveTo(list.handle(), p2Arg, p3Arg, p4Arg, C.int(numSegments))
+}
+
+// PathBezierQuadraticCurveTo calls PathBezierQuadraticCurveToV(p2, p3, 0).
+func (list DrawList) PathBezierQuadraticCurveTo(p2 Vec2, p3 Vec2) {
+	list.PathBezierQuadraticCurveToV(p2, p3, 0)
+}
+
+// PathBezierQuadraticCurveToV adds the points of a quadratic Bezier curve to the path. The curve starts at the last
+// point of the path, and ends at p3, with the control point p2.
+// A numSegments of 0 tessellates the curve according to Style.CurveTessellationTol.
+func (list DrawList) PathBezierQuadraticCurveToV(p2 Vec2, p3 Vec2, numSegments int) {
+	p2Arg, _ := p2.wrapped()
+	p3Arg, _ := p3.wrapped()
+	C.iggPathBezierQuadraticCurveTo(list.handle(), p2Arg, p3Arg, C.int(numSegments))
+}
+
+// PathRect calls PathRectV(rectMin, rectMax, 0, DrawFlagsNone).
+func (list DrawList) PathRect(rectMin Vec2, rectMax Vec2) {
+	list.PathRectV(rectMin, rectMax, 0, DrawFlagsNone)
+}
+
+// PathRectV adds the points of a rectangle to the path, in clockwise order.
+// flags indicate which corners are rounded, as for AddRectV().
+func (list DrawList) PathRectV(rectMin Vec2, rectMax Vec2, rounding float32, flags DrawFlags) {
+	rectMinArg, _ := rectMin.wrapped()
+	rectMaxArg, _ := rectMax.wrapped()
+	C.iggPathRect(list.handle(), rectMinArg, rectMaxArg, C.float(rounding), C.int(flags))
+}
+
 // PushClipRect performs render-level scissoring.
 // It calls PushClipRectV(min, max, false).
 func (list DrawList) PushClipRect(min, max Vec2) {
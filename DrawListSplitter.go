@@ -0,0 +1,47 @@
+package imgui
+
+// #include "wrapper/DrawListSplitter.h"
+import "C"
+
+// DrawListSplitter splits a draw list into channels, which can be drawn into out of order, and merged back.
+// It is the same as the channels of DrawList, but can be used within the split of the list itself,
+// or kept by the caller to reuse its memory over several frames.
+//
+// A splitter must only be used with one list at a time.
+type DrawListSplitter struct {
+	handle C.IggDrawListSplitter
+}
+
+// NewDrawListSplitter creates a new splitter. It needs to be released with Delete().
+func NewDrawListSplitter() *DrawListSplitter {
+	return &DrawListSplitter{handle: C.iggDrawListSplitterNew()}
+}
+
+// Delete releases the splitter and its memory.
+func (splitter *DrawListSplitter) Delete() {
+	if splitter.handle != nil {
+		C.iggDrawListSplitterDelete(splitter.handle)
+		splitter.handle = nil
+	}
+}
+
+// Clear resets the splitter to a single channel, keeping its memory for reuse.
+func (splitter *DrawListSplitter) Clear() {
+	C.iggDrawListSplitterClear(splitter.handle)
+}
+
+// Split splits the list into count channels. Channel 0 is drawn first, and becomes the current channel.
+// Merge() must be called before the list is used by ImGui again.
+func (splitter *DrawListSplitter) Split(list DrawList, count int) {
+	C.iggDrawListSplitterSplit(splitter.handle, list.handle(), C.int(count))
+}
+
+// SetCurrentChannel makes the channel of the given index the target of the following primitives of the list.
+func (splitter *DrawListSplitter) SetCurrentChannel(list DrawList, index int) {
+	C.iggDrawListSplitterSetCurrentChannel(splitter.handle, list.handle(), C.int(index))
+}
+
+// Merge joins the channels back into the list, in order of their index.
+func (splitter *DrawListSplitter) Merge(list DrawList) {
+	C.iggDrawListSplitterMerge(splitter.handle, list.handle())
+}
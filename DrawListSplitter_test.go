@@ -0,0 +1,60 @@
+package imgui_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+func TestDrawListSplitterWithinChannels(t *testing.T) {
+	back := imgui.Packed(color.RGBA{R: 0x11, A: 0xFF})
+	middle := imgui.Packed(color.RGBA{G: 0x22, A: 0xFF})
+	front := imgui.Packed(color.RGBA{B: 0x33, A: 0xFF})
+	splitter := imgui.NewDrawListSplitter()
+	defer splitter.Delete()
+
+	withDrawList(t, func(list imgui.DrawList) {
+		list.ChannelsSplit(2)
+		list.ChannelsSetCurrent(1)
+		list.AddRectFilled(imgui.Vec2{X: 10, Y: 10}, imgui.Vec2{X: 20, Y: 20}, front)
+		list.ChannelsSetCurrent(0)
+
+		splitter.Split(list, 2)
+		splitter.SetCurrentChannel(list, 1)
+		list.AddRectFilled(imgui.Vec2{X: 30, Y: 30}, imgui.Vec2{X: 40, Y: 40}, middle)
+		splitter.SetCurrentChannel(list, 0)
+		list.AddRectFilled(imgui.Vec2{X: 50, Y: 50}, imgui.Vec2{X: 60, Y: 60}, back)
+		splitter.Merge(list)
+
+		list.ChannelsMerge()
+
+		backIndex, middleIndex, frontIndex := firstIndexOfColor(list, back), firstIndexOfColor(list, middle), firstIndexOfColor(list, front)
+		require.True(t, (backIndex >= 0) && (middleIndex >= 0) && (frontIndex >= 0))
+		assert.True(t, backIndex < middleIndex, "channels of the splitter should be merged in order")
+		assert.True(t, middleIndex < frontIndex, "splitter should be merged into channel 0 of the list")
+	})
+}
+
+func TestDrawListSplitterIsReusable(t *testing.T) {
+	splitter := imgui.NewDrawListSplitter()
+	defer splitter.Delete()
+
+	for frame := 0; frame < 2; frame++ {
+		withDrawList(t, func(list imgui.DrawList) {
+			commands := len(list.Commands())
+			splitter.Split(list, 3)
+			splitter.SetCurrentChannel(list, 2)
+			splitter.SetCurrentChannel(list, 1)
+			splitter.Merge(list)
+			splitter.Clear()
+			assert.Equal(t, commands, len(list.Commands()), "empty channels should not add commands")
+		})
+	}
+
+	splitter.Delete()
+	splitter.Delete()
+}
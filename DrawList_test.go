@@ -0,0 +1,198 @@
+package imgui_test
+
+import (
+	"image/color"
+	"math"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// drawListGeometry returns the vertices added to the list by draw, and the number of indices.
+func drawListGeometry(list imgui.DrawList, draw func()) (vertices []byte, indexCount int) {
+	verticesBefore, indicesBefore := drawListBytes(list)
+	draw()
+	verticesAfter, indicesAfter := drawListBytes(list)
+	return verticesAfter[len(verticesBefore):], (len(indicesAfter) - len(indicesBefore)) / imgui.IndexBufferLayout()
+}
+
+func withDrawList(t *testing.T, draw func(list imgui.DrawList)) {
+	t.Helper()
+	newTestContext(t)
+
+	imgui.NewFrame()
+	imgui.Begin("canvas")
+	draw(imgui.WindowDrawList())
+	imgui.End()
+	imgui.Render()
+}
+
+func TestDrawListPathMatchesPolyline(t *testing.T) {
+	withDrawList(t, func(list imgui.DrawList) {
+		col := imgui.Packed(color.RGBA{R: 0xFF, A: 0xFF})
+		points := make([]imgui.Vec2, 100)
+		for i := range points {
+			points[i] = imgui.Vec2{X: 10 + float32(i)*3, Y: 100 + 40*float32(math.Sin(float64(i)/8))}
+		}
+
+		polyline, polylineIndices := drawListGeometry(list, func() {
+			list.AddPolyline(points, col, imgui.DrawFlagsNone, 2)
+		})
+		path, pathIndices := drawListGeometry(list, func() {
+			for _, p := range points {
+				list.PathLineTo(p)
+			}
+			list.PathStrokeV(col, imgui.DrawFlagsNone, 2)
+		})
+		require.NotEmpty(t, polyline)
+		assert.Equal(t, polyline, path, "stroked path should produce the vertices of the polyline")
+		assert.Equal(t, polylineIndices, pathIndices)
+
+		corners := []imgui.Vec2{{X: 20, Y: 20}, {X: 80, Y: 20}, {X: 80, Y: 60}, {X: 20, Y: 60}}
+		polygon, _ := drawListGeometry(list, func() {
+			list.AddConvexPolyFilled(corners, col)
+		})
+		rect, _ := drawListGeometry(list, func() {
+			list.PathRect(corners[0], corners[2])
+			list.PathFillConvex(col)
+		})
+		require.NotEmpty(t, polygon)
+		assert.Equal(t, polygon, rect, "path of a rectangle should be its corners in clockwise order")
+
+		cleared, _ := drawListGeometry(list, func() {
+			list.PathLineTo(corners[0])
+			list.PathLineTo(corners[1])
+			list.PathClear()
+			list.PathStroke(col)
+		})
+		assert.Empty(t, cleared, "cleared path should not draw anything")
+	})
+}
+
+func TestDrawListPrimitivesAddGeometry(t *testing.T) {
+	withDrawList(t, func(list imgui.DrawList) {
+		col := imgui.Packed(color.White)
+		center := imgui.Vec2{X: 100, Y: 100}
+		radius := imgui.Vec2{X: 40, Y: 20}
+		p1, p2, p3, p4 := imgui.Vec2{X: 10, Y: 10}, imgui.Vec2{X: 60, Y: 15}, imgui.Vec2{X: 55, Y: 70}, imgui.Vec2{X: 5, Y: 60}
+		concave := []imgui.Vec2{{X: 0, Y: 0}, {X: 40, Y: 0}, {X: 40, Y: 10}, {X: 10, Y: 10}, {X: 10, Y: 40}, {X: 0, Y: 40}}
+
+		primitives := map[string]func(){
+			"AddQuad":              func() { list.AddQuad(p1, p2, p3, p4, col) },
+			"AddQuadFilled":        func() { list.AddQuadFilled(p1, p2, p3, p4, col) },
+			"AddNgon":              func() { list.AddNgon(center, 30, col, 6) },
+			"AddNgonFilled":        func() { list.AddNgonFilled(center, 30, col, 6) },
+			"AddEllipse":           func() { list.AddEllipse(center, radius, col) },
+			"AddEllipseFilledV":    func() { list.AddEllipseFilledV(center, radius, col, 0.5, 16) },
+			"AddBezierCubic":       func() { list.AddBezierCubic(p1, p2, p3, p4, col, 1) },
+			"AddBezierQuadraticV":  func() { list.AddBezierQuadraticV(p1, p2, p3, col, 1, 8) },
+			"AddConcavePolyFilled": func() { list.AddConcavePolyFilled(concave, col) },
+			"AddImageRounded":      func() { list.AddImageRounded(imgui.TextureID(1), p1, p3, 4) },
+			"PathArcTo": func() {
+				list.PathArcTo(center, 30, 0, math.Pi)
+				list.PathStroke(col)
+			},
+			"PathArcToFast": func() {
+				list.PathLineTo(center)
+				list.PathArcToFast(center, 30, 0, 3)
+				list.PathFillConvex(col)
+			},
+			"PathEllipticalArcTo": func() {
+				list.PathEllipticalArcTo(center, radius, 0, 0, 2*math.Pi)
+				list.PathFillConvex(col)
+			},
+			"PathBezierCurves": func() {
+				list.PathLineTo(p1)
+				list.PathBezierCubicCurveTo(p2, p3, p4)
+				list.PathBezierQuadraticCurveTo(p1, p2)
+				list.PathStrokeV(col, imgui.DrawFlagsClosed, 2)
+			},
+			"PathRectV": func() {
+				list.PathRectV(p1, p3, 8, imgui.DrawFlagsRoundCornersTop)
+				list.PathFillConcave(col)
+			},
+		}
+		for name, draw := range primitives {
+			vertices, indexCount := drawListGeometry(list, draw)
+			assert.NotEmpty(t, vertices, "%s should add vertices", name)
+			assert.True(t, (indexCount > 0) && (indexCount%3 == 0), "%s should add triangles", name)
+		}
+
+		empty, _ := drawListGeometry(list, func() {
+			list.AddPolyline(nil, col, imgui.DrawFlagsClosed, 1)
+			list.AddConvexPolyFilled([]imgui.Vec2{}, col)
+		})
+		assert.Empty(t, empty, "empty point lists should not draw anything")
+	})
+}
+
+// firstIndexOfColor returns the position of the first index of the list that refers to a vertex
+// of the given color, or -1. Channels reorder indices, the vertices stay in order of submission.
+func firstIndexOfColor(list imgui.DrawList, col imgui.PackedColor) int {
+	vertices := list.Vertices()
+	for i, index := range list.Indices() {
+		if vertices[index].Col == col {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestDrawListChannelsReorderPrimitives(t *testing.T) {
+	box := imgui.Packed(color.RGBA{R: 0x10, G: 0x20, B: 0x30, A: 0xFF})
+	line := imgui.Packed(color.RGBA{R: 0x40, G: 0x50, B: 0x60, A: 0xFF})
+	withDrawList(t, func(list imgui.DrawList) {
+		list.ChannelsSplit(2)
+		list.ChannelsSetCurrent(1)
+		list.AddRectFilled(imgui.Vec2{X: 20, Y: 20}, imgui.Vec2{X: 60, Y: 60}, box)
+		list.ChannelsSetCurrent(0)
+		list.AddLine(imgui.Vec2{X: 10, Y: 10}, imgui.Vec2{X: 80, Y: 80}, line)
+		list.ChannelsMerge()
+
+		boxIndex, lineIndex := firstIndexOfColor(list, box), firstIndexOfColor(list, line)
+		require.True(t, (boxIndex >= 0) && (lineIndex >= 0))
+		assert.True(t, lineIndex < boxIndex, "line of channel 0 should be drawn before the box submitted earlier")
+	})
+}
+
+func TestDrawListVerticesAndIndices(t *testing.T) {
+	require.Equal(t, imgui.IndexBufferLayout(), int(unsafe.Sizeof(imgui.DrawIdx(0))), "DrawIdx should have the size of ImDrawIdx")
+
+	withDrawList(t, func(list imgui.DrawList) {
+		col := imgui.Packed(color.RGBA{R: 0x12, G: 0x34, B: 0x56, A: 0x78})
+		min, max := imgui.Vec2{X: 20, Y: 30}, imgui.Vec2{X: 70, Y: 50}
+		list.AddRectFilledV(min, max, col, 0, imgui.DrawFlagsNone)
+
+		vertexBytes, indexBytes := drawListBytes(list)
+		vertices, indices := list.Vertices(), list.Indices()
+		entrySize, _, _, _ := imgui.VertexBufferLayout()
+		require.Equal(t, len(vertexBytes)/entrySize, len(vertices))
+		require.Equal(t, len(indexBytes)/imgui.IndexBufferLayout(), len(indices))
+
+		base := len(vertices) - 4
+		white := vertices[base].UV
+		assert.Equal(t, []imgui.DrawVert{
+			{Pos: min, UV: white, Col: col},
+			{Pos: imgui.Vec2{X: max.X, Y: min.Y}, UV: white, Col: col},
+			{Pos: max, UV: white, Col: col},
+			{Pos: imgui.Vec2{X: min.X, Y: max.Y}, UV: white, Col: col},
+		}, vertices[base:], "vertices of the rectangle should be decoded")
+		b := imgui.DrawIdx(base)
+		assert.Equal(t, []imgui.DrawIdx{b, b + 1, b + 2, b, b + 2, b + 3}, indices[len(indices)-6:],
+			"indices of the rectangle should be decoded")
+	})
+}
+
+func TestDrawListVerticesOfEmptyList(t *testing.T) {
+	newTestContext(t)
+
+	imgui.NewFrame()
+	list := imgui.BackgroundDrawList()
+	assert.Empty(t, list.Vertices())
+	assert.Empty(t, list.Indices())
+	imgui.Render()
+}
@@ -118,6 +118,17 @@ func (atlas FontAtlas) AddFontFromMemoryTTF(fontData []byte, sizePixels float32)
 	return atlas.AddFontFromMemoryTTFV(fontData, sizePixels, DefaultFontConfig, EmptyGlyphRanges)
 }
 
+// Fonts returns the fonts of the atlas, in the order they were added.
+// If no font was added, the atlas adds the default font when it is built, see Build().
+func (atlas FontAtlas) Fonts() []Font {
+	count := int(C.iggFontAtlasGetFontCount(atlas.handle()))
+	fonts := make([]Font, count)
+	for i := range fonts {
+		fonts[i] = Font(C.iggFontAtlasGetFont(atlas.handle(), C.int(i)))
+	}
+	return fonts
+}
+
 // SetTexDesiredWidth registers the width desired by user before building the image. Must be a power-of-two.
 // If have many glyphs your graphics API have texture size restrictions you may want to increase texture width to decrease height.
 // Set to 0 by default, causing auto-calculation.
@@ -46,6 +46,36 @@ func (io IO) WantTextInput() bool {
 	return C.iggWantTextInput(io.handle) != 0
 }
 
+// WantSetMousePos returns true if MousePos has been altered by ImGui, and the platform backend
+// should reposition the OS mouse cursor on the next frame.
+// This is only set when the navigation moves the mouse, see IOConfig.NavMoveSetMousePos.
+func (io IO) WantSetMousePos() bool {
+	return C.iggWantSetMousePos(io.handle) != 0
+}
+
+// WantSaveIniSettings returns true if the settings have changed and should be saved.
+// This is only set when the automatic saving of the .ini file is disabled with SetIniFilename("").
+// Save the data of SaveIniSettingsToMemory() yourself, and then call ClearWantSaveIniSettings().
+func (io IO) WantSaveIniSettings() bool {
+	return C.iggWantSaveIniSettings(io.handle) != 0
+}
+
+// ClearWantSaveIniSettings resets the WantSaveIniSettings flag after the settings have been saved.
+func (io IO) ClearWantSaveIniSettings() {
+	C.iggIoSetWantSaveIniSettings(io.handle, castBool(false))
+}
+
+// NavActive returns true if keyboard/gamepad navigation is currently allowed,
+// i.e. a window is focused and it doesn't use the WindowFlagsNoNavInputs flag.
+func (io IO) NavActive() bool {
+	return C.iggNavActive(io.handle) != 0
+}
+
+// NavVisible returns true if the keyboard/gamepad navigation highlight is visible and allowed.
+func (io IO) NavVisible() bool {
+	return C.iggNavVisible(io.handle) != 0
+}
+
 // Framerate application estimation, in frame per second. Solely for convenience.
 // Rolling average estimation based on IO.DeltaTime over 120 frames.
 func (io IO) Framerate() float32 {
@@ -67,6 +97,16 @@ func (io IO) MetricsRenderWindows() int {
 	return int(C.iggMetricsRenderWindows(io.handle))
 }
 
+// MetricsRenderWindowsForViewport returns the number of windows rendered into the given viewport
+// during the last call to Render().
+//
+// Dear ImGui only counts the rendered windows of all viewports, see MetricsRenderWindows(). This function instead
+// counts the windows of which the draw list is part of the draw data of the viewport. Windows that did not draw
+// anything are not part of the draw data, and are not counted. The result is 0 after the next NewFrame().
+func (io IO) MetricsRenderWindowsForViewport(viewport Viewport) int {
+	return int(C.iggMetricsRenderWindowsForViewport(io.handle, viewport.handle()))
+}
+
 // MetricsActiveWindows returns number of active windows.
 func (io IO) MetricsActiveWindows() int {
 	return int(C.iggMetricsActiveWindows(io.handle))
@@ -91,6 +131,44 @@ func (io IO) MouseDelta() Vec2 {
 	return value
 }
 
+// MouseClickedCount returns the number of successive clicks of the given mouse button, at the frame
+// the button went down: 1 for a single click, 2 for a double-click, etc. It is 0 in all other frames.
+func (io IO) MouseClickedCount(button int) int {
+	return int(C.iggIoGetMouseClickedCount(io.handle, C.int(button)))
+}
+
+// MouseDownDuration returns the duration the given mouse button has been down, in seconds.
+// 0.0 means it went down in the current frame, and -1.0 means it is not down.
+func (io IO) MouseDownDuration(button int) float32 {
+	return float32(C.iggIoGetMouseDownDuration(io.handle, C.int(button)))
+}
+
+// KeyData is the state of a key, as returned by IO.GetKeyData().
+type KeyData struct {
+	// Down is true if the key is down.
+	Down bool
+	// DownDuration is the duration the key has been down, in seconds.
+	// 0.0 means it went down in the current frame, and -1.0 means it is not down.
+	DownDuration float32
+	// DownDurationPrev is the DownDuration of the previous frame.
+	DownDurationPrev float32
+	// AnalogValue is the value of an analog key, such as a gamepad trigger, in the range 0.0 to 1.0.
+	AnalogValue float32
+}
+
+// GetKeyData returns the state of the given key. The modifier keys KeyModCtrl, KeyModShift, KeyModAlt
+// and KeyModSuper are supported as well. For any other key, an unpressed state is returned.
+func (io IO) GetKeyData(key ImguiKey) KeyData {
+	var data C.IggKeyData
+	C.iggIoGetKeyData(io.handle, C.int(key), &data)
+	return KeyData{
+		Down:             data.Down != 0,
+		DownDuration:     float32(data.DownDuration),
+		DownDurationPrev: float32(data.DownDurationPrev),
+		AnalogValue:      float32(data.AnalogValue),
+	}
+}
+
 // MouseWheel returns the mouse wheel movement.
 func (io IO) MouseWheel() (float32, float32) {
 	var mouseWheelH, mouseWheel C.float
@@ -127,6 +205,7 @@ func (io IO) Fonts() FontAtlas {
 
 // SetMousePosition sets the mouse position, in pixels.
 // Set to Vec2(-math.MaxFloat32,-mathMaxFloat32) if mouse is unavailable (on another screen, etc.).
+// Prefer AddMousePosEvent(), which keeps the order of events within a frame.
 func (io IO) SetMousePosition(value Vec2) {
 	posArg, _ := value.wrapped()
 	C.iggIoSetMousePosition(io.handle, posArg)
@@ -137,6 +216,7 @@ func (io IO) SetMousePosition(value Vec2) {
 // ImGui itself mostly only uses left button (BeginPopupContext** are using right button).
 // Other buttons allows us to track if the mouse is being used by your application +
 // available to user as a convenience via IsMouse** API.
+// Prefer AddMouseButtonEvent(), which does not lose a press and release within the same frame.
 func (io IO) SetMouseButtonDown(index int, down bool) {
 	var downArg C.IggBool
 	if down {
@@ -198,6 +278,91 @@ func (io IO) AddInputCharacters(chars string) {
 	C.iggIoAddInputCharactersUTF8(io.handle, textArg)
 }
 
+// MouseSource identifies the kind of device that generates the mouse input.
+type MouseSource int
+
+const (
+	// MouseSourceMouse is an actual mouse.
+	MouseSourceMouse MouseSource = 0
+	// MouseSourceTouchScreen is a touch screen: no hovering prior to initial press, less precise initial press aiming,
+	// dual-axis wheeling possible.
+	MouseSourceTouchScreen MouseSource = 1
+	// MouseSourcePen is a pressure/magnetic pen, often used in conjunction with high-sampling rates.
+	MouseSourcePen MouseSource = 2
+)
+
+// AddKeyAnalogEvent queues a new key down/up event for analog values (e.g. KeyGamepad values).
+// Dead-zones should be handled by the backend.
+func (io IO) AddKeyAnalogEvent(key ImguiKey, down bool, value float32) {
+	C.iggIoAddKeyAnalogEvent(io.handle, C.int(key), castBool(down), C.float(value))
+}
+
+// AddMousePosEvent queues a mouse position update, in pixels.
+// Use Vec2{-math.MaxFloat32, -math.MaxFloat32} to signify no mouse (e.g. app not focused and not hovered).
+//
+// Unlike SetMousePosition(), all events are kept in order, even if several happen within one frame.
+func (io IO) AddMousePosEvent(pos Vec2) {
+	C.iggIoAddMousePosEvent(io.handle, C.float(pos.X), C.float(pos.Y))
+}
+
+// AddMouseButtonEvent queues a mouse button change.
+//
+// Unlike SetMouseButtonDown(), a press and release within the same frame is not lost.
+func (io IO) AddMouseButtonEvent(button int, down bool) {
+	C.iggIoAddMouseButtonEvent(io.handle, C.int(button), castBool(down))
+}
+
+// AddMouseWheelEvent queues a mouse wheel update.
+// wheelY<0: scroll down, wheelY>0: scroll up, wheelX<0: scroll right, wheelX>0: scroll left.
+func (io IO) AddMouseWheelEvent(wheelX, wheelY float32) {
+	C.iggIoAddMouseWheelEvent(io.handle, C.float(wheelX), C.float(wheelY))
+}
+
+// AddMouseSourceEvent queues a change of the mouse source.
+func (io IO) AddMouseSourceEvent(source MouseSource) {
+	C.iggIoAddMouseSourceEvent(io.handle, C.int(source))
+}
+
+// AddFocusEvent queues a gain/loss of focus for the application (generally based on OS/platform focus of your window).
+// Losing the focus releases all keys and mouse buttons.
+func (io IO) AddFocusEvent(focused bool) {
+	C.iggIoAddFocusEvent(io.handle, castBool(focused))
+}
+
+// AddInputCharacterUTF16 queues a new character input from a UTF-16 character. It can be a surrogate.
+func (io IO) AddInputCharacterUTF16(c uint16) {
+	C.iggIoAddInputCharacterUTF16(io.handle, C.ushort(c))
+}
+
+// SetKeyEventNativeData specifies the native keycode and scancode of the key of the next key event.
+// nativeLegacyIndex is the index for the legacy (< 1.87) IsKeyXXX() functions, or -1.
+func (io IO) SetKeyEventNativeData(key ImguiKey, nativeKeycode, nativeScancode, nativeLegacyIndex int) {
+	C.iggIoSetKeyEventNativeData(io.handle, C.int(key), C.int(nativeKeycode), C.int(nativeScancode), C.int(nativeLegacyIndex))
+}
+
+// ClearInputKeys clears the current keyboard/gamepad state and the text input buffer of the current frame.
+// Equivalent to releasing all keys/buttons.
+func (io IO) ClearInputKeys() {
+	C.iggIoClearInputKeys(io.handle)
+}
+
+// ClearInputMouse clears the current mouse state.
+func (io IO) ClearInputMouse() {
+	C.iggIoClearInputMouse(io.handle)
+}
+
+// SetConfigInputTrickleEventQueue enables input queue trickling: some types of events submitted during the same frame
+// (e.g. button down + up) will be spread over multiple frames, improving interactions with low framerates.
+// Enabled by default.
+func (io IO) SetConfigInputTrickleEventQueue(value bool) {
+	C.iggIoSetConfigInputTrickleEventQueue(io.handle, castBool(value))
+}
+
+// ConfigInputTrickleEventQueue returns whether input queue trickling is enabled.
+func (io IO) ConfigInputTrickleEventQueue() bool {
+	return C.iggIoGetConfigInputTrickleEventQueue(io.handle) != 0
+}
+
 // SetIniFilename changes the filename for the settings. Default: "imgui.ini".
 // Use an empty string to disable the ini from being used.
 func (io IO) SetIniFilename(value string) {
@@ -236,6 +401,11 @@ func (io IO) SetConfigFlags(flags ConfigFlags) {
 	C.iggIoSetConfigFlags(io.handle, C.int(flags))
 }
 
+// GetConfigFlags gets the gamepad/keyboard navigation options, etc.
+func (io IO) GetConfigFlags() ConfigFlags {
+	return ConfigFlags(C.iggIoGetConfigFlags(io.handle))
+}
+
 // BackendFlags for IO.SetBackendFlags.
 type BackendFlags int
 
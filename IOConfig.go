@@ -0,0 +1,190 @@
+package imgui
+
+// #include "wrapper/IO.h"
+import "C"
+
+// IOConfig contains the configuration options of IO.
+// Read the current configuration with IO.Config(), change it and apply it in one call with IO.SetConfig().
+// The fields carry the names of the ImGuiIO members, without the "Config" prefix.
+//
+// A zero IOConfig is not a sensible configuration. Always start from the value returned by IO.Config().
+type IOConfig struct {
+	// Flags are the keyboard/gamepad navigation options, etc.
+	Flags ConfigFlags
+	// IniSavingRate is the minimum time between saving positions/sizes to the .ini file, in seconds. Default: 5.0.
+	IniSavingRate float32
+	// NavSwapGamepadButtons swaps Activate<>Cancel (A<>B) buttons, matching typical "Nintendo/Japanese style" gamepad layout.
+	NavSwapGamepadButtons bool
+	// NavMoveSetMousePos makes directional/tabbing navigation teleport the mouse cursor. The backend needs to reposition the OS mouse cursor.
+	NavMoveSetMousePos bool
+	// NavCaptureKeyboard sets WantCaptureKeyboard when navigation is active. Default: true.
+	NavCaptureKeyboard bool
+	// NavEscapeClearFocusItem lets Escape clear the focused item and the navigation highlight. Default: true.
+	NavEscapeClearFocusItem bool
+	// NavEscapeClearFocusWindow lets Escape clear the focused window as well.
+	NavEscapeClearFocusWindow bool
+	// NavCursorVisibleAuto makes the navigation cursor visible when using directional navigation keys, and hides it on mouse click. Default: true.
+	NavCursorVisibleAuto bool
+	// NavCursorVisibleAlways keeps the navigation cursor always visible.
+	NavCursorVisibleAlways bool
+	// DockingNoSplit disables window splitting, so docking is limited to merging windows into tab bars.
+	DockingNoSplit bool
+	// DockingWithShift enables docking only while holding the Shift key.
+	DockingWithShift bool
+	// DockingAlwaysTabBar makes every single floating window display within a docking node.
+	DockingAlwaysTabBar bool
+	// DockingTransparentPayload makes a window transparent while it is being docked.
+	DockingTransparentPayload bool
+	// MouseDrawCursor requests ImGui to draw a mouse cursor, for platforms without one.
+	MouseDrawCursor bool
+	// MacOSXBehaviors swaps Cmd<>Ctrl keys and enables OS X style text editing and shortcuts.
+	MacOSXBehaviors bool
+	// InputTrickleEventQueue spreads some events submitted within the same frame over multiple frames. Default: true.
+	InputTrickleEventQueue bool
+	// InputTextCursorBlink enables the blinking cursor of text inputs. Default: true.
+	InputTextCursorBlink bool
+	// InputTextEnterKeepActive keeps a single-line text input active when pressing Enter, and selects its contents.
+	InputTextEnterKeepActive bool
+	// DragClickToInputText turns Drag widgets into text inputs with a simple click-release, without moving.
+	DragClickToInputText bool
+	// WindowsResizeFromEdges enables resizing of windows from their edges and from the lower-left corner. Default: true.
+	WindowsResizeFromEdges bool
+	// WindowsMoveFromTitleBarOnly allows moving windows only by their title bar.
+	WindowsMoveFromTitleBarOnly bool
+	// WindowsCopyContentsWithCtrlC copies the contents of the focused window into the clipboard on Ctrl+C. Experimental.
+	WindowsCopyContentsWithCtrlC bool
+	// ScrollbarScrollByPage scrolls page by page when clicking outside the scrollbar grab. Default: true.
+	ScrollbarScrollByPage bool
+	// MemoryCompactTimer is the time, in seconds, after which unused transient buffers are freed. -1 disables it. Default: 60.
+	MemoryCompactTimer float32
+	// MouseDoubleClickTime is the time for a double-click, in seconds. Default: 0.30.
+	MouseDoubleClickTime float32
+	// MouseDoubleClickMaxDist is the distance to stay within to validate a double-click, in pixels. Default: 6.
+	MouseDoubleClickMaxDist float32
+	// MouseDragThreshold is the distance before considering the mouse to be dragging, in pixels. Default: 6.
+	MouseDragThreshold float32
+	// KeyRepeatDelay is the time before a held key starts repeating, in seconds. Default: 0.275.
+	KeyRepeatDelay float32
+	// KeyRepeatRate is the rate at which a held key repeats, in seconds. Default: 0.050.
+	KeyRepeatRate float32
+	// ErrorRecovery enables the recovery from errors such as unbalanced Push/Pop calls. Default: true.
+	ErrorRecovery bool
+	// ErrorRecoveryEnableAssert calls the assert handler on recoverable errors. Default: true.
+	ErrorRecoveryEnableAssert bool
+	// ErrorRecoveryEnableDebugLog writes recoverable errors to the debug log. Default: true.
+	ErrorRecoveryEnableDebugLog bool
+	// ErrorRecoveryEnableTooltip shows a tooltip on recoverable errors. Default: true.
+	ErrorRecoveryEnableTooltip bool
+	// DebugIsDebuggerPresent enables various tools that break into the debugger.
+	DebugIsDebuggerPresent bool
+	// DebugHighlightIDConflicts highlights items with conflicting identifiers. Default: true.
+	DebugHighlightIDConflicts bool
+	// DebugHighlightIDConflictsShowItemPicker shows the "Item Picker" button in the conflict popup. Default: true.
+	DebugHighlightIDConflictsShowItemPicker bool
+	// DebugBeginReturnValueOnce makes first-time calls to Begin()/BeginChild() return false. Needs to be set at boot time.
+	DebugBeginReturnValueOnce bool
+	// DebugBeginReturnValueLoop makes some calls to Begin()/BeginChild() return false, cycling through window depths.
+	DebugBeginReturnValueLoop bool
+	// DebugIgnoreFocusLoss ignores AddFocusEvent(false), so keys and mouse buttons are not released.
+	DebugIgnoreFocusLoss bool
+	// DebugIniSettings saves the .ini data with extra comments.
+	DebugIniSettings bool
+}
+
+// Config returns the current configuration options.
+func (io IO) Config() IOConfig {
+	out := &C.IggIOConfig{}
+	C.iggIoGetConfig(io.handle, out)
+	return IOConfig{
+		Flags:                                   ConfigFlags(out.ConfigFlags),
+		IniSavingRate:                           float32(out.IniSavingRate),
+		NavSwapGamepadButtons:                   out.ConfigNavSwapGamepadButtons != 0,
+		NavMoveSetMousePos:                      out.ConfigNavMoveSetMousePos != 0,
+		NavCaptureKeyboard:                      out.ConfigNavCaptureKeyboard != 0,
+		NavEscapeClearFocusItem:                 out.ConfigNavEscapeClearFocusItem != 0,
+		NavEscapeClearFocusWindow:               out.ConfigNavEscapeClearFocusWindow != 0,
+		NavCursorVisibleAuto:                    out.ConfigNavCursorVisibleAuto != 0,
+		NavCursorVisibleAlways:                  out.ConfigNavCursorVisibleAlways != 0,
+		DockingNoSplit:                          out.ConfigDockingNoSplit != 0,
+		DockingWithShift:                        out.ConfigDockingWithShift != 0,
+		DockingAlwaysTabBar:                     out.ConfigDockingAlwaysTabBar != 0,
+		DockingTransparentPayload:               out.ConfigDockingTransparentPayload != 0,
+		MouseDrawCursor:                         out.MouseDrawCursor != 0,
+		MacOSXBehaviors:                         out.ConfigMacOSXBehaviors != 0,
+		InputTrickleEventQueue:                  out.ConfigInputTrickleEventQueue != 0,
+		InputTextCursorBlink:                    out.ConfigInputTextCursorBlink != 0,
+		InputTextEnterKeepActive:                out.ConfigInputTextEnterKeepActive != 0,
+		DragClickToInputText:                    out.ConfigDragClickToInputText != 0,
+		WindowsResizeFromEdges:                  out.ConfigWindowsResizeFromEdges != 0,
+		WindowsMoveFromTitleBarOnly:             out.ConfigWindowsMoveFromTitleBarOnly != 0,
+		WindowsCopyContentsWithCtrlC:            out.ConfigWindowsCopyContentsWithCtrlC != 0,
+		ScrollbarScrollByPage:                   out.ConfigScrollbarScrollByPage != 0,
+		MemoryCompactTimer:                      float32(out.ConfigMemoryCompactTimer),
+		MouseDoubleClickTime:                    float32(out.MouseDoubleClickTime),
+		MouseDoubleClickMaxDist:                 float32(out.MouseDoubleClickMaxDist),
+		MouseDragThreshold:                      float32(out.MouseDragThreshold),
+		KeyRepeatDelay:                          float32(out.KeyRepeatDelay),
+		KeyRepeatRate:                           float32(out.KeyRepeatRate),
+		ErrorRecovery:                           out.ConfigErrorRecovery != 0,
+		ErrorRecoveryEnableAssert:               out.ConfigErrorRecoveryEnableAssert != 0,
+		ErrorRecoveryEnableDebugLog:             out.ConfigErrorRecoveryEnableDebugLog != 0,
+		ErrorRecoveryEnableTooltip:              out.ConfigErrorRecoveryEnableTooltip != 0,
+		DebugIsDebuggerPresent:                  out.ConfigDebugIsDebuggerPresent != 0,
+		DebugHighlightIDConflicts:               out.ConfigDebugHighlightIdConflicts != 0,
+		DebugHighlightIDConflictsShowItemPicker: out.ConfigDebugHighlightIdConflictsShowItemPicker != 0,
+		DebugBeginReturnValueOnce:               out.ConfigDebugBeginReturnValueOnce != 0,
+		DebugBeginReturnValueLoop:               out.ConfigDebugBeginReturnValueLoop != 0,
+		DebugIgnoreFocusLoss:                    out.ConfigDebugIgnoreFocusLoss != 0,
+		DebugIniSettings:                        out.ConfigDebugIniSettings != 0,
+	}
+}
+
+// SetConfig applies all configuration options.
+func (io IO) SetConfig(config IOConfig) {
+	C.iggIoSetConfig(io.handle, config.wrapped())
+}
+
+func (config IOConfig) wrapped() *C.IggIOConfig {
+	return &C.IggIOConfig{
+		ConfigFlags:                                   C.int(config.Flags),
+		IniSavingRate:                                 C.float(config.IniSavingRate),
+		ConfigNavSwapGamepadButtons:                   castBool(config.NavSwapGamepadButtons),
+		ConfigNavMoveSetMousePos:                      castBool(config.NavMoveSetMousePos),
+		ConfigNavCaptureKeyboard:                      castBool(config.NavCaptureKeyboard),
+		ConfigNavEscapeClearFocusItem:                 castBool(config.NavEscapeClearFocusItem),
+		ConfigNavEscapeClearFocusWindow:               castBool(config.NavEscapeClearFocusWindow),
+		ConfigNavCursorVisibleAuto:                    castBool(config.NavCursorVisibleAuto),
+		ConfigNavCursorVisibleAlways:                  castBool(config.NavCursorVisibleAlways),
+		ConfigDockingNoSplit:                          castBool(config.DockingNoSplit),
+		ConfigDockingWithShift:                        castBool(config.DockingWithShift),
+		ConfigDockingAlwaysTabBar:                     castBool(config.DockingAlwaysTabBar),
+		ConfigDockingTransparentPayload:               castBool(config.DockingTransparentPayload),
+		MouseDrawCursor:                               castBool(config.MouseDrawCursor),
+		ConfigMacOSXBehaviors:                         castBool(config.MacOSXBehaviors),
+		ConfigInputTrickleEventQueue:                  castBool(config.InputTrickleEventQueue),
+		ConfigInputTextCursorBlink:                    castBool(config.InputTextCursorBlink),
+		ConfigInputTextEnterKeepActive:                castBool(config.InputTextEnterKeepActive),
+		ConfigDragClickToInputText:                    castBool(config.DragClickToInputText),
+		ConfigWindowsResizeFromEdges:                  castBool(config.WindowsResizeFromEdges),
+		ConfigWindowsMoveFromTitleBarOnly:             castBool(config.WindowsMoveFromTitleBarOnly),
+		ConfigWindowsCopyContentsWithCtrlC:            castBool(config.WindowsCopyContentsWithCtrlC),
+		ConfigScrollbarScrollByPage:                   castBool(config.ScrollbarScrollByPage),
+		ConfigMemoryCompactTimer:                      C.float(config.MemoryCompactTimer),
+		MouseDoubleClickTime:                          C.float(config.MouseDoubleClickTime),
+		MouseDoubleClickMaxDist:                       C.float(config.MouseDoubleClickMaxDist),
+		MouseDragThreshold:                            C.float(config.MouseDragThreshold),
+		KeyRepeatDelay:                                C.float(config.KeyRepeatDelay),
+		KeyRepeatRate:                                 C.float(config.KeyRepeatRate),
+		ConfigErrorRecovery:                           castBool(config.ErrorRecovery),
+		ConfigErrorRecoveryEnableAssert:               castBool(config.ErrorRecoveryEnableAssert),
+		ConfigErrorRecoveryEnableDebugLog:             castBool(config.ErrorRecoveryEnableDebugLog),
+		ConfigErrorRecoveryEnableTooltip:              castBool(config.ErrorRecoveryEnableTooltip),
+		ConfigDebugIsDebuggerPresent:                  castBool(config.DebugIsDebuggerPresent),
+		ConfigDebugHighlightIdConflicts:               castBool(config.DebugHighlightIDConflicts),
+		ConfigDebugHighlightIdConflictsShowItemPicker: castBool(config.DebugHighlightIDConflictsShowItemPicker),
+		ConfigDebugBeginReturnValueOnce:               castBool(config.DebugBeginReturnValueOnce),
+		ConfigDebugBeginReturnValueLoop:               castBool(config.DebugBeginReturnValueLoop),
+		ConfigDebugIgnoreFocusLoss:                    castBool(config.DebugIgnoreFocusLoss),
+		ConfigDebugIniSettings:                        castBool(config.DebugIniSettings),
+	}
+}
@@ -0,0 +1,167 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/jetsetilly/imgui-go/v5"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMouseButtonEventsWithinOneFrameAreNotLost(t *testing.T) {
+	newTestContext(t)
+
+	io := imgui.CurrentIO()
+	assert.True(t, io.ConfigInputTrickleEventQueue(), "trickling should be enabled by default")
+
+	var buttonCenter imgui.Vec2
+	clicks := 0
+	step := func() {
+		imgui.NewFrame()
+		imgui.SetNextWindowPos(imgui.Vec2{})
+		imgui.Begin("Buttons")
+		if imgui.Button("Press") {
+			clicks++
+		}
+		min, max := imgui.ItemRectMin(), imgui.ItemRectMax()
+		buttonCenter = imgui.Vec2{X: (min.X + max.X) / 2, Y: (min.Y + max.Y) / 2}
+		imgui.End()
+		imgui.Render()
+	}
+
+	step()
+	step()
+	io.AddMouseSourceEvent(imgui.MouseSourceMouse)
+	io.AddMousePosEvent(buttonCenter)
+	io.AddMouseButtonEvent(0, true)
+	io.AddMouseButtonEvent(0, false)
+	for frame := 0; frame < 4; frame++ {
+		step()
+	}
+
+	assert.Equal(t, 1, clicks, "fast click should be registered")
+}
+
+func TestFocusLossReleasesKeys(t *testing.T) {
+	newTestContext(t)
+
+	io := imgui.CurrentIO()
+
+	step := func() {
+		imgui.NewFrame()
+		imgui.Render()
+	}
+
+	io.AddKeyEvent(imgui.KeyModCtrl, true)
+	step()
+	assert.True(t, io.KeyCtrlPressed())
+	io.AddFocusEvent(false)
+	step()
+	assert.False(t, io.KeyCtrlPressed())
+}
+
+func TestIOConfigCanBeReadAndApplied(t *testing.T) {
+	newTestContext(t)
+
+	io := imgui.CurrentIO()
+	config := io.Config()
+	assert.Equal(t, float32(0.30), config.MouseDoubleClickTime, "default expected")
+	assert.True(t, config.ErrorRecovery, "default expected")
+	assert.False(t, config.WindowsMoveFromTitleBarOnly, "default expected")
+
+	config.Flags = imgui.ConfigFlagsNavEnableKeyboard | imgui.ConfigFlagsDockingEnable
+	config.MouseDoubleClickTime = 0.5
+	config.KeyRepeatRate = 0.1
+	config.WindowsMoveFromTitleBarOnly = true
+	config.WindowsResizeFromEdges = false
+	config.DebugHighlightIDConflicts = false
+	io.SetConfig(config)
+
+	assert.Equal(t, config, io.Config())
+	assert.Equal(t, imgui.ConfigFlagsNavEnableKeyboard|imgui.ConfigFlagsDockingEnable, io.GetConfigFlags())
+}
+
+func TestOutputStateReportsKeysMouseAndMetrics(t *testing.T) {
+	newTestContext(t)
+
+	io := imgui.CurrentIO()
+	io.SetBackendFlags(imgui.BackendFlagsHasGamepad)
+	io.SetConfigInputTrickleEventQueue(false)
+
+	step := func() {
+		imgui.NewFrame()
+		imgui.Begin("First")
+		imgui.Text("first")
+		imgui.End()
+		imgui.Begin("Second")
+		imgui.Text("second")
+		imgui.End()
+		imgui.Render()
+	}
+	step()
+
+	io.AddKeyAnalogEvent(imgui.KeyGamepadL2, true, 0.5)
+	io.AddMousePosEvent(imgui.Vec2{X: 700, Y: 500})
+	io.AddMouseButtonEvent(0, true)
+	step()
+	assert.Equal(t, 1, io.MouseClickedCount(0))
+	assert.Equal(t, float32(0), io.MouseDownDuration(0))
+	assert.Equal(t, float32(-1), io.MouseDownDuration(1))
+	assert.Equal(t, 0, io.MouseClickedCount(99), "out of range button should be ignored")
+	data := io.GetKeyData(imgui.KeyGamepadL2)
+	assert.True(t, data.Down)
+	assert.Equal(t, float32(0.5), data.AnalogValue)
+
+	io.AddMouseButtonEvent(0, false)
+	step()
+	io.AddMouseButtonEvent(0, true)
+	step()
+	assert.Equal(t, 2, io.MouseClickedCount(0), "second click should be a double-click")
+	assert.True(t, io.GetKeyData(imgui.KeyGamepadL2).DownDuration > 0)
+	assert.False(t, io.GetKeyData(imgui.KeyA).Down)
+	assert.False(t, io.GetKeyData(imgui.KeyNone).Down)
+
+	assert.Equal(t, io.MetricsRenderWindows(), io.MetricsRenderWindowsForViewport(imgui.MainViewport()))
+	assert.True(t, io.MetricsRenderWindows() >= 2, "both windows should be rendered")
+	assert.False(t, io.WantSetMousePos())
+
+	for frame := 0; frame < 400 && !io.WantSaveIniSettings(); frame++ {
+		step()
+	}
+	assert.True(t, io.WantSaveIniSettings(), "new windows should request saving the settings")
+	io.ClearWantSaveIniSettings()
+	assert.False(t, io.WantSaveIniSettings())
+}
+
+func TestMetricsRenderWindowsForViewportCountsRenderedWindows(t *testing.T) {
+	newTestContext(t)
+
+	io := imgui.CurrentIO()
+	viewport := imgui.MainViewport()
+	frame := func(second bool) {
+		imgui.NewFrame()
+		imgui.Begin("first")
+		imgui.BeginChild("child")
+		imgui.Text("child")
+		imgui.EndChild()
+		imgui.End()
+		if second {
+			imgui.Begin("second")
+			imgui.Text("second")
+			imgui.End()
+		}
+		imgui.Render()
+	}
+
+	frame(true)
+	frame(true)
+	assert.Equal(t, io.MetricsRenderWindows(), io.MetricsRenderWindowsForViewport(viewport))
+	assert.Equal(t, 3, io.MetricsRenderWindowsForViewport(viewport), "windows and child window should be counted")
+
+	frame(false)
+	assert.Equal(t, 2, io.MetricsRenderWindowsForViewport(viewport), "window that was not begun should not be counted")
+
+	imgui.NewFrame()
+	assert.Equal(t, 0, io.MetricsRenderWindowsForViewport(viewport), "draw data of the viewport should be invalid")
+	imgui.Render()
+}
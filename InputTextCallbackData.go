@@ -3,7 +3,6 @@ package imgui
 // #include "wrapper/InputTextCallbackData.h"
 import "C"
 import (
-	"sync"
 	"unsafe"
 )
 
@@ -14,13 +13,11 @@ type InputTextCallback func(InputTextCallbackData) int32
 type inputTextState struct {
 	buf *stringBuffer
 
+	owner    *contextState
 	key      C.int
 	callback InputTextCallback
 }
 
-var inputTextStates = make(map[C.int]*inputTextState)
-var inputTextStatesMutex sync.Mutex
-
 func newInputTextState(text string, cb InputTextCallback) *inputTextState {
 	state := &inputTextState{}
 	state.buf = newStringBuffer(text)
@@ -30,23 +27,25 @@ func newInputTextState(text string, cb InputTextCallback) *inputTextState {
 }
 
 func (state *inputTextState) register() {
-	inputTextStatesMutex.Lock()
-	defer inputTextStatesMutex.Unlock()
-	key := C.int(len(inputTextStates) + 1)
-	for _, existing := inputTextStates[key]; existing; _, existing = inputTextStates[key] {
+	owner := currentState()
+	owner.mutex.Lock()
+	defer owner.mutex.Unlock()
+	key := C.int(len(owner.inputTextStates) + 1)
+	for _, existing := owner.inputTextStates[key]; existing; _, existing = owner.inputTextStates[key] {
 		key++
 	}
+	state.owner = owner
 	state.key = key
-	inputTextStates[key] = state
+	owner.inputTextStates[key] = state
 }
 
 func (state *inputTextState) release() {
 	state.buf.free()
 
 	if state.key != 0 {
-		inputTextStatesMutex.Lock()
-		defer inputTextStatesMutex.Unlock()
-		delete(inputTextStates, state.key)
+		state.owner.mutex.Lock()
+		defer state.owner.mutex.Unlock()
+		delete(state.owner.inputTextStates, state.key)
 	}
 }
 
@@ -70,9 +69,10 @@ func iggInputTextCallback(handle C.IggInputTextCallbackData, key C.int) C.int {
 }
 
 func iggInputTextStateFor(key C.int) *inputTextState {
-	inputTextStatesMutex.Lock()
-	defer inputTextStatesMutex.Unlock()
-	return inputTextStates[key]
+	owner := currentState()
+	owner.mutex.Lock()
+	defer owner.mutex.Unlock()
+	return owner.inputTextStates[key]
 }
 
 // InputTextCallbackData represents the shared state of InputText(), passed as an argument to your callback.
@@ -0,0 +1,118 @@
+package imgui
+
+// #include "wrapper/ItemRegistry.h"
+import "C"
+
+import "strings"
+
+// Item registry
+// While enabled, the current context records every item with an ID submitted during a frame: windows, widgets
+// and the decorations of windows such as the title bar. Items without an ID, such as Text(), are not recorded,
+// and neither are the items of hidden windows.
+// The registry is cleared at the start of every frame; the hovered, active and focused state of the items is
+// determined at the end of the frame. After Render(), RegisteredItems() describes the last frame.
+// The registry uses the hooks that ImGui provides for its test engine. It is disabled by default.
+
+// SetItemRegistryEnabled enables or disables the item registry of the current context.
+// It does nothing if there is no current context.
+func SetItemRegistryEnabled(enabled bool) {
+	C.iggSetItemRegistryEnabled(castBool(enabled))
+}
+
+// IsItemRegistryEnabled returns true if the item registry of the current context is enabled.
+func IsItemRegistryEnabled() bool {
+	return C.iggIsItemRegistryEnabled() != 0
+}
+
+// RegisteredItem describes an item recorded by the item registry.
+type RegisteredItem struct {
+	// ID is the ID of the item.
+	ID ID
+	// WindowID is the ID of the window the item was submitted in. For a window, it is its own ID.
+	WindowID ID
+	// Window is the name of the window the item was submitted in.
+	Window string
+	// Label is the label of the item, including any "##" suffix. It is empty for items that don't report one.
+	Label string
+	// Min is the upper-left corner of the item, in screen space.
+	Min Vec2
+	// Max is the lower-right corner of the item, in screen space.
+	Max Vec2
+	// ClipMin is the upper-left corner of the clip rectangle at the time the item was submitted.
+	ClipMin Vec2
+	// ClipMax is the lower-right corner of the clip rectangle at the time the item was submitted.
+	ClipMax Vec2
+	// Hovered is true if the mouse hovers the item. For a window, it is true if the mouse hovers the window.
+	Hovered bool
+	// Active is true if the item is active, e.g. a button being held or a text field being edited.
+	Active bool
+	// Focused is true if the item has the keyboard/gamepad navigation focus. For a window, it is true if the window is focused.
+	Focused bool
+}
+
+// DisplayLabel returns the visible part of the label, which is the part before any "##".
+func (item RegisteredItem) DisplayLabel() string {
+	return displayLabel(item.Label)
+}
+
+// displayLabel returns the part of a label before any "##".
+func displayLabel(label string) string {
+	if index := strings.Index(label, "##"); index >= 0 {
+		return label[:index]
+	}
+	return label
+}
+
+// IsWindow returns true if the item represents a window.
+func (item RegisteredItem) IsWindow() bool {
+	return item.ID == item.WindowID
+}
+
+// VisibleRect returns the part of the item within its clip rectangle.
+// The returned rectangle is empty (min >= max) if the item is clipped entirely.
+func (item RegisteredItem) VisibleRect() (visibleMin, visibleMax Vec2) {
+	visibleMin = Vec2{X: max(item.Min.X, item.ClipMin.X), Y: max(item.Min.Y, item.ClipMin.Y)}
+	visibleMax = Vec2{X: min(item.Max.X, item.ClipMax.X), Y: min(item.Max.Y, item.ClipMax.Y)}
+	return visibleMin, visibleMax
+}
+
+// RegisteredItems returns the items recorded in the current or last frame, in the order they were submitted.
+// It returns nil if the item registry is disabled.
+func RegisteredItems() []RegisteredItem {
+	count := int(C.iggRegisteredItemCount())
+	if count == 0 {
+		return nil
+	}
+	items := make([]RegisteredItem, count)
+	for i := range items {
+		var out C.IggRegisteredItem
+		C.iggGetRegisteredItem(C.int(i), &out)
+		items[i] = newRegisteredItem(&out)
+	}
+	return items
+}
+
+// LookupRegisteredItem returns the item with given ID, if it was recorded in the current or last frame.
+func LookupRegisteredItem(id ID) (RegisteredItem, bool) {
+	var out C.IggRegisteredItem
+	if C.iggLookupRegisteredItem(C.uint(id), &out) == 0 {
+		return RegisteredItem{}, false
+	}
+	return newRegisteredItem(&out), true
+}
+
+func newRegisteredItem(out *C.IggRegisteredItem) RegisteredItem {
+	return RegisteredItem{
+		ID:       ID(out.ID),
+		WindowID: ID(out.WindowID),
+		Window:   C.GoString(out.Window),
+		Label:    C.GoString(out.Label),
+		Min:      Vec2{X: float32(out.Min.x), Y: float32(out.Min.y)},
+		Max:      Vec2{X: float32(out.Max.x), Y: float32(out.Max.y)},
+		ClipMin:  Vec2{X: float32(out.ClipMin.x), Y: float32(out.ClipMin.y)},
+		ClipMax:  Vec2{X: float32(out.ClipMax.x), Y: float32(out.ClipMax.y)},
+		Hovered:  out.Hovered != 0,
+		Active:   out.Active != 0,
+		Focused:  out.Focused != 0,
+	}
+}
@@ -0,0 +1,96 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+func TestItemRegistryRecordsItemsOfLastFrame(t *testing.T) {
+	newTestContext(t)
+
+	io := imgui.CurrentIO()
+	assert.False(t, imgui.IsItemRegistryEnabled(), "registry should be disabled by default")
+	imgui.SetItemRegistryEnabled(true)
+	require.True(t, imgui.IsItemRegistryEnabled())
+
+	var buttonID imgui.ID
+	var buttonMin, buttonSize imgui.Vec2
+	var textID imgui.ID
+	showCheckbox := true
+	checked := false
+	step := func() {
+		imgui.NewFrame()
+		imgui.SetNextWindowPos(imgui.Vec2{X: 20, Y: 20})
+		imgui.SetNextWindowSize(imgui.Vec2{X: 200, Y: 100})
+		imgui.Begin("Registry")
+		imgui.Text("no ID")
+		textID = imgui.GetItemID()
+		imgui.Button("Apply##primary")
+		buttonID = imgui.GetItemID()
+		buttonMin = imgui.ItemRectMin()
+		buttonSize = imgui.ItemRectSize()
+		if showCheckbox {
+			imgui.Checkbox("Check", &checked)
+		}
+		imgui.End()
+		imgui.Render()
+	}
+	step()
+	step()
+
+	assert.Equal(t, imgui.ID(0), textID, "text should have no ID")
+	assert.Equal(t, imgui.GetIDWithSeed("Apply##primary", imgui.GetIDWithSeed("Registry", 0)), buttonID)
+	assert.True(t, (buttonSize.X > 0) && (buttonSize.Y > 0), "button should have a size")
+
+	button, found := imgui.LookupRegisteredItem(buttonID)
+	require.True(t, found, "button should be registered")
+	assert.Equal(t, "Registry", button.Window)
+	assert.Equal(t, "Apply##primary", button.Label)
+	assert.Equal(t, "Apply", button.DisplayLabel())
+	assert.Equal(t, buttonMin, button.Min)
+	assert.Equal(t, buttonMin.Plus(buttonSize), button.Max)
+	assert.False(t, button.Hovered)
+	assert.False(t, button.IsWindow())
+
+	io.AddMousePosEvent(buttonMin.Plus(buttonSize.Times(0.5)))
+	io.AddMouseButtonEvent(0, true)
+	step()
+	step()
+	button, _ = imgui.LookupRegisteredItem(buttonID)
+	assert.True(t, button.Hovered, "button should be hovered")
+	assert.True(t, button.Active, "button should be active while held")
+
+	var labels []string
+	for _, item := range imgui.RegisteredItems() {
+		labels = append(labels, item.Label)
+	}
+	assert.Contains(t, labels, "Registry")
+	assert.Contains(t, labels, "Check")
+
+	showCheckbox = false
+	step()
+	for _, item := range imgui.RegisteredItems() {
+		assert.NotEqual(t, "Check", item.Label, "items of earlier frames should be removed")
+	}
+
+	imgui.SetItemRegistryEnabled(false)
+	assert.Nil(t, imgui.RegisteredItems())
+}
+
+func TestItemRegistryWithoutContextIsDisabled(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	require.NoError(t, context.SetCurrent())
+	context.Destroy()
+	_, err := imgui.CurrentContext()
+	require.Equal(t, imgui.ErrNoContext, err)
+
+	imgui.SetItemRegistryEnabled(true)
+	assert.False(t, imgui.IsItemRegistryEnabled())
+	assert.Nil(t, imgui.RegisteredItems())
+	_, found := imgui.LookupRegisteredItem(1)
+	assert.False(t, found)
+}
@@ -20,6 +20,27 @@ func PopID() {
 	C.iggPopID()
 }
 
+// ID is a unique identifier of a window, an item, a dock node, etc.
+// IDs are hashes of the entire ID stack.
+type ID uint32
+
+// GetID calculates a unique ID by hashing the entire ID stack with the given string.
+// Useful if you want to query into ImGuiStorage, or to refer to a dock space.
+func GetID(strID string) ID {
+	strIDArg, strIDFin := wrapString(strID)
+	defer strIDFin()
+	return ID(C.iggGetID(strIDArg))
+}
+
+// GetIDWithSeed calculates the ID of the given string as if seed were the only entry of the ID stack.
+// The ID of a window is GetIDWithSeed(name, 0), and the ID of an item directly within the window is
+// GetIDWithSeed(label, windowID). This allows calculating IDs outside of the window.
+func GetIDWithSeed(strID string, seed ID) ID {
+	strIDArg, strIDFin := wrapString(strID)
+	defer strIDFin()
+	return ID(C.iggGetIDWithSeed(strIDArg, C.uint(seed)))
+}
+
 // Separator is generally horizontal. Inside a menu bar or in horizontal layout mode, this becomes a vertical separator.
 func Separator() {
 	C.iggSeparator()
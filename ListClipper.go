@@ -3,6 +3,7 @@ package imgui
 // #include "wrapper/ListClipper.h"
 import "C"
 import (
+	"iter"
 	"runtime/cgo"
 )
 
@@ -36,3 +37,138 @@ func ListClipperAll(itemsCount int, draw func(int)) ListClipper {
 		ItemsHeight:  float32(results.ItemsHeight),
 	}
 }
+
+// Clipper gives direct access to the list clipper of Dear ImGui, for a manual Begin(), Step() and End() loop.
+// The items from DisplayStart() to DisplayEnd() (exclusive) need to be submitted after every call to Step().
+// Prefer Clip() for a range-over-func loop.
+type Clipper struct {
+	handle  C.IggListClipper
+	results C.IggListClipperResults
+}
+
+// NewClipper creates a new clipper. It needs to be released with Delete().
+func NewClipper() *Clipper {
+	return &Clipper{handle: C.iggListClipperNew()}
+}
+
+// Delete releases the clipper. It ends an unfinished clipping.
+func (clipper *Clipper) Delete() {
+	if clipper.handle != nil {
+		C.iggListClipperDelete(clipper.handle)
+		clipper.handle = nil
+	}
+}
+
+// Begin starts clipping a list of itemsCount items.
+// A negative itemsHeight lets the clipper measure the height of the first item.
+//
+// Within a BeginMultiSelect()/EndMultiSelect() scope, the item of MultiSelectIO.RangeSrcItem() is never clipped,
+// assuming the selection user data are item indices.
+func (clipper *Clipper) Begin(itemsCount int, itemsHeight float32) {
+	C.iggListClipperBegin(clipper.handle, C.int(itemsCount), C.float(itemsHeight))
+}
+
+// End finishes the clipping. It is called automatically by the last call of Step(), which returns false.
+func (clipper *Clipper) End() {
+	C.iggListClipperEnd(clipper.handle)
+}
+
+// Step advances the clipper. Call until it returns false, and submit the items of the current display range each time.
+func (clipper *Clipper) Step() bool {
+	return C.iggListClipperStep(clipper.handle, &clipper.results) != 0
+}
+
+// DisplayStart returns the first item to display, updated by each call to Step().
+func (clipper *Clipper) DisplayStart() int {
+	return int(clipper.results.DisplayStart)
+}
+
+// DisplayEnd returns the end of the items to display (exclusive), updated by each call to Step().
+func (clipper *Clipper) DisplayEnd() int {
+	return int(clipper.results.DisplayEnd)
+}
+
+// ItemsHeight returns the height of an item, as given to Begin() or as measured after the first step.
+func (clipper *Clipper) ItemsHeight() float32 {
+	return float32(clipper.results.ItemsHeight)
+}
+
+// IncludeItemsByIndex makes the items from itemBegin to itemEnd (exclusive) never clipped.
+// Call it after Begin() and before the first call to Step().
+func (clipper *Clipper) IncludeItemsByIndex(itemBegin, itemEnd int) {
+	C.iggListClipperIncludeItemsByIndex(clipper.handle, C.int(itemBegin), C.int(itemEnd))
+}
+
+// IncludeItemByIndex calls IncludeItemsByIndex(itemIndex, itemIndex+1).
+func (clipper *Clipper) IncludeItemByIndex(itemIndex int) {
+	clipper.IncludeItemsByIndex(itemIndex, itemIndex+1)
+}
+
+// SeekCursorForItem moves the cursor to the position of the given item, based on the height of the items.
+// This is only reliable for lists with a known, fixed item height.
+func (clipper *Clipper) SeekCursorForItem(itemIndex int) {
+	C.iggListClipperSeekCursorForItem(clipper.handle, C.int(itemIndex))
+}
+
+// ClipOptions modify the behaviour of ClipV().
+type ClipOptions struct {
+	// ItemsHeight is the fixed height of every item. If zero, the height of the first item is measured.
+	ItemsHeight float32
+	// IncludeItems are indices of items that are never clipped, such as the selected row.
+	IncludeItems []int
+	// ScrollToItem, if not nil, is the index of an item to scroll to.
+	// Set it only for the frame in which the scrolling should happen.
+	ScrollToItem *int
+	// ScrollRatio is the vertical position of the scrolled-to item within the window:
+	// 0.0: top, 0.5: center, 1.0: bottom.
+	ScrollRatio float32
+}
+
+// Clip calls ClipV(itemsCount, ClipOptions{}).
+//
+//	for i := range imgui.Clip(len(rows)) {
+//		imgui.Text(rows[i])
+//	}
+func Clip(itemsCount int) iter.Seq[int] {
+	return ClipV(itemsCount, ClipOptions{})
+}
+
+// ClipV returns an iterator over the indices of the items of a list that are visible, and need to be submitted.
+// Unlike ListClipperAll(), it does not need a callback from native code for each item.
+// Breaking out of the loop ends the clipping.
+func ClipV(itemsCount int, options ClipOptions) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		clipper := NewClipper()
+		defer clipper.Delete()
+
+		itemsHeight := options.ItemsHeight
+		if itemsHeight <= 0 {
+			itemsHeight = -1
+		}
+		clipper.Begin(itemsCount, itemsHeight)
+		include := func(index int) {
+			if (index >= 0) && (index < itemsCount) {
+				clipper.IncludeItemByIndex(index)
+			}
+		}
+		for _, index := range options.IncludeItems {
+			include(index)
+		}
+		scrollToItem := -1
+		if options.ScrollToItem != nil {
+			scrollToItem = *options.ScrollToItem
+			include(scrollToItem)
+		}
+
+		for clipper.Step() {
+			for i := clipper.DisplayStart(); i < clipper.DisplayEnd(); i++ {
+				if !yield(i) {
+					return
+				}
+				if i == scrollToItem {
+					SetScrollHereY(options.ScrollRatio)
+				}
+			}
+		}
+	}
+}
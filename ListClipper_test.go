@@ -0,0 +1,66 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/jetsetilly/imgui-go/v5"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClipIteratesVisibleItemsOnly(t *testing.T) {
+	newTestContext(t)
+
+	const itemsCount = 1000000
+	const itemsHeight = 20
+	var visited []int
+	var scrollY float32
+	step := func(options imgui.ClipOptions) {
+		visited = nil
+		imgui.NewFrame()
+		imgui.SetNextWindowPos(imgui.Vec2{})
+		imgui.SetNextWindowSize(imgui.Vec2{X: 200, Y: 400})
+		imgui.Begin("Trace")
+		for i := range imgui.ClipV(itemsCount, options) {
+			visited = append(visited, i)
+			imgui.Dummy(imgui.Vec2{X: 10, Y: itemsHeight - imgui.CurrentStyle().ItemSpacing().Y})
+		}
+		scrollY = imgui.ScrollY()
+		imgui.End()
+		imgui.Render()
+	}
+
+	step(imgui.ClipOptions{ItemsHeight: itemsHeight, IncludeItems: []int{500000}})
+	assert.True(t, len(visited) < 40, "only visible items expected")
+	assert.Equal(t, 0, visited[0])
+	assert.Contains(t, visited, 500000, "included item expected")
+
+	row := 900000
+	step(imgui.ClipOptions{ItemsHeight: itemsHeight, ScrollToItem: &row})
+	step(imgui.ClipOptions{ItemsHeight: itemsHeight})
+	assert.InDelta(t, float32(row*itemsHeight), scrollY, 2*itemsHeight, "window should be scrolled to the row")
+	assert.Contains(t, visited, row, "row should be visible after scrolling")
+}
+
+func TestClipEndsClippingOnBreak(t *testing.T) {
+	newTestContext(t)
+
+	var count int
+	for frame := 0; frame < 2; frame++ {
+		imgui.NewFrame()
+		imgui.SetNextWindowSize(imgui.Vec2{X: 200, Y: 400})
+		imgui.Begin("List")
+		count = 0
+		for range imgui.Clip(100) {
+			imgui.Text("item")
+			count++
+			if count == 3 {
+				break
+			}
+		}
+		imgui.Text("after")
+		imgui.End()
+		imgui.Render()
+	}
+	assert.Equal(t, 3, count)
+}
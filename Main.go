@@ -14,13 +14,18 @@ func Time() float64 {
 }
 
 // NewFrame starts a new ImGui frame, you can submit any command from this point until Render()/EndFrame().
+// Assertions recorded for the previous frame are cleared, and the draw callbacks of the previous frame are released.
 func NewFrame() {
+	clearFrameAssertions()
+	currentState().releaseDrawCallbacks()
 	C.iggNewFrame()
+	accessibilityNewFrame()
 }
 
 // Render ends the ImGui frame, finalize the draw data.
 // After this method, call RenderedDrawData to retrieve the draw commands and execute them.
 func Render() {
+	accessibilityEndFrame()
 	C.iggRender()
 }
 
@@ -28,5 +33,6 @@ func Render() {
 // call that yourself directly. If you don't need to render you may call EndFrame() but you'll have
 // wasted CPU already. If you don't need to render, better to not create any imgui windows instead!
 func EndFrame() {
+	accessibilityEndFrame()
 	C.iggEndFrame()
 }
@@ -6,8 +6,24 @@ import (
 	"github.com/jetsetilly/imgui-go/v5"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
+// newTestContext creates a current context for headless tests, which is destroyed at the end of the test.
+// The context does not save an ini file, has a display size of 800x600 and a built font atlas,
+// so frames can be run right away.
+func newTestContext(t *testing.T) *imgui.Context {
+	t.Helper()
+	context := imgui.CreateContext(nil)
+	t.Cleanup(context.Destroy)
+	require.NoError(t, context.SetCurrent())
+	io := imgui.CurrentIO()
+	io.SetIniFilename("")
+	io.SetDisplaySize(imgui.Vec2{X: 800, Y: 600})
+	io.Fonts().TextureDataRGBA32()
+	return context
+}
+
 func TestVersion(t *testing.T) {
 	version := imgui.Version()
 	assert.Equal(t, "1.91.9b", version)
@@ -0,0 +1,180 @@
+package imgui
+
+// #include "wrapper/MultiSelect.h"
+import "C"
+
+// Multi-selection system
+// - This enables standard multi-selection/range-selection idioms (CTRL+Mouse/Keyboard, SHIFT+Mouse/Keyboard, etc.)
+//   in a way that also allow a clipper to be used.
+// - Identify submitted items with SetNextItemSelectionUserData(), most likely using an index into your current data-set.
+// - Consider using SelectionStorage, which applies the requests to a set of selected items.
+// Usage:
+// - BEGIN - Call BeginMultiSelect() and apply the requests of the returned MultiSelectIO.
+// - LOOP  - Submit your items with SetNextItemSelectionUserData() + Selectable()/TreeNode() calls.
+// - END   - Call EndMultiSelect() and apply the requests of the returned MultiSelectIO.
+// ListClipperAll() makes sure the source item of a range selection is never clipped.
+
+// MultiSelectFlags for BeginMultiSelectV().
+type MultiSelectFlags int
+
+const (
+	// MultiSelectFlagsNone specifies the default behavior.
+	MultiSelectFlagsNone MultiSelectFlags = 0
+	// MultiSelectFlagsSingleSelect disables selecting more than one item.
+	MultiSelectFlagsSingleSelect MultiSelectFlags = 1 << 0
+	// MultiSelectFlagsNoSelectAll disables CTRL+A shortcut to select all.
+	MultiSelectFlagsNoSelectAll MultiSelectFlags = 1 << 1
+	// MultiSelectFlagsNoRangeSelect disables Shift+selection mouse/keyboard support.
+	MultiSelectFlagsNoRangeSelect MultiSelectFlags = 1 << 2
+	// MultiSelectFlagsNoAutoSelect disables selecting items when navigating.
+	MultiSelectFlagsNoAutoSelect MultiSelectFlags = 1 << 3
+	// MultiSelectFlagsNoAutoClear disables clearing selection when navigating or selecting another one.
+	MultiSelectFlagsNoAutoClear MultiSelectFlags = 1 << 4
+	// MultiSelectFlagsNoAutoClearOnReselect disables clearing selection when clicking/selecting an already selected item.
+	MultiSelectFlagsNoAutoClearOnReselect MultiSelectFlags = 1 << 5
+	// MultiSelectFlagsBoxSelect1d enables box-selection with same width and same x pos items (e.g. full row Selectable()).
+	MultiSelectFlagsBoxSelect1d MultiSelectFlags = 1 << 6
+	// MultiSelectFlagsBoxSelect2d enables box-selection with varying width or varying x pos items support.
+	MultiSelectFlagsBoxSelect2d MultiSelectFlags = 1 << 7
+	// MultiSelectFlagsBoxSelectNoScroll disables scrolling when box-selecting near edges of scope.
+	MultiSelectFlagsBoxSelectNoScroll MultiSelectFlags = 1 << 8
+	// MultiSelectFlagsClearOnEscape clears selection when pressing Escape while scope is focused.
+	MultiSelectFlagsClearOnEscape MultiSelectFlags = 1 << 9
+	// MultiSelectFlagsClearOnClickVoid clears selection when clicking on empty location within scope.
+	MultiSelectFlagsClearOnClickVoid MultiSelectFlags = 1 << 10
+	// MultiSelectFlagsScopeWindow sets the scope for box-selection and ClearOnClickVoid to the whole window (Default).
+	MultiSelectFlagsScopeWindow MultiSelectFlags = 1 << 11
+	// MultiSelectFlagsScopeRect sets the scope for box-selection and ClearOnClickVoid to the rectangle
+	// encompassing BeginMultiSelect()/EndMultiSelect().
+	MultiSelectFlagsScopeRect MultiSelectFlags = 1 << 12
+	// MultiSelectFlagsSelectOnClick applies selection on mouse down when clicking on unselected item (Default).
+	MultiSelectFlagsSelectOnClick MultiSelectFlags = 1 << 13
+	// MultiSelectFlagsSelectOnClickRelease applies selection on mouse release when clicking an unselected item.
+	// Allows dragging an unselected item without altering selection.
+	MultiSelectFlagsSelectOnClickRelease MultiSelectFlags = 1 << 14
+	// MultiSelectFlagsNavWrapX enables navigation wrapping on X axis.
+	MultiSelectFlagsNavWrapX MultiSelectFlags = 1 << 16
+)
+
+// SelectionUserData identifies an item in a multi-selection. Most likely, it is the index of the item.
+type SelectionUserData int64
+
+// SelectionUserDataInvalid marks an invalid value.
+const SelectionUserDataInvalid SelectionUserData = -1
+
+// SelectionRequestType is the type of a SelectionRequest.
+type SelectionRequestType int
+
+const (
+	// SelectionRequestTypeNone is an empty request.
+	SelectionRequestTypeNone SelectionRequestType = 0
+	// SelectionRequestTypeSetAll requests to clear the selection (if Selected is false) or to select all items (if Selected is true).
+	SelectionRequestTypeSetAll SelectionRequestType = 1
+	// SelectionRequestTypeSetRange requests to select/unselect [RangeFirstItem..RangeLastItem] items (inclusive)
+	// based on the value of Selected.
+	SelectionRequestTypeSetRange SelectionRequestType = 2
+)
+
+// SelectionRequest is a request to change the selection, as reported by MultiSelectIO.
+type SelectionRequest struct {
+	Type           SelectionRequestType
+	Selected       bool              // Parameter for SetAll/SetRange requests (true = select, false = unselect)
+	RangeDirection int8              // Parameter for SetRange request: +1 when RangeFirstItem comes before RangeLastItem, -1 otherwise.
+	RangeFirstItem SelectionUserData // Parameter for SetRange request.
+	RangeLastItem  SelectionUserData // Parameter for SetRange request. Inclusive!
+}
+
+// MultiSelectIO is the structure to interact with a BeginMultiSelect()/EndMultiSelect() block.
+// Lifetime: don't hold on this value past the next call to BeginMultiSelect() or EndMultiSelect().
+type MultiSelectIO uintptr
+
+func (io MultiSelectIO) handle() C.IggMultiSelectIO {
+	return C.IggMultiSelectIO(io)
+}
+
+// BeginMultiSelect starts a multi-selection scope and returns the requests to apply to the selection.
+// selectionSize is the number of selected items, or -1 if unknown; it is used to optimize ctrl+A.
+// itemsCount is the number of items, or -1 if unknown; SelectionStorage requires it.
+func BeginMultiSelect(flags MultiSelectFlags, selectionSize int, itemsCount int) MultiSelectIO {
+	return MultiSelectIO(C.iggBeginMultiSelect(C.int(flags), C.int(selectionSize), C.int(itemsCount)))
+}
+
+// EndMultiSelect ends the multi-selection scope and returns the requests to apply to the selection.
+func EndMultiSelect() MultiSelectIO {
+	return MultiSelectIO(C.iggEndMultiSelect())
+}
+
+// SetNextItemSelectionUserData sets the identifier of the next item within a multi-selection scope.
+func SetNextItemSelectionUserData(selectionUserData SelectionUserData) {
+	C.iggSetNextItemSelectionUserData(C.int64_t(selectionUserData))
+}
+
+// IsItemToggledSelection returns true if the selection state of the last item was toggled.
+// Useful if you need the per-item information before reaching EndMultiSelect().
+func IsItemToggledSelection() bool {
+	return C.iggIsItemToggledSelection() != 0
+}
+
+// Requests returns the requests to apply to the selection.
+func (io MultiSelectIO) Requests() []SelectionRequest {
+	if io == 0 {
+		return nil
+	}
+	count := int(C.iggMultiSelectIOGetRequestsCount(io.handle()))
+	requests := make([]SelectionRequest, count)
+	for i := 0; i < count; i++ {
+		out := &C.IggSelectionRequest{}
+		C.iggMultiSelectIOGetRequest(io.handle(), C.int(i), out)
+
+		requests[i] = SelectionRequest{
+			Type:           SelectionRequestType(out.Type),
+			Selected:       out.Selected != 0,
+			RangeDirection: int8(out.RangeDirection),
+			RangeFirstItem: SelectionUserData(out.RangeFirstItem),
+			RangeLastItem:  SelectionUserData(out.RangeLastItem),
+		}
+	}
+	return requests
+}
+
+// RangeSrcItem returns the source item of a range selection, often the first selected item.
+// When using a clipper, this item must never be clipped.
+func (io MultiSelectIO) RangeSrcItem() SelectionUserData {
+	if io == 0 {
+		return SelectionUserDataInvalid
+	}
+	return SelectionUserData(C.iggMultiSelectIOGetRangeSrcItem(io.handle()))
+}
+
+// NavIDItem returns the last known selection user data of the navigated item. Useful when deleting items.
+func (io MultiSelectIO) NavIDItem() SelectionUserData {
+	if io == 0 {
+		return SelectionUserDataInvalid
+	}
+	return SelectionUserData(C.iggMultiSelectIOGetNavIdItem(io.handle()))
+}
+
+// NavIDSelected returns the last known selection state of the navigated item. Useful when deleting items.
+func (io MultiSelectIO) NavIDSelected() bool {
+	if io == 0 {
+		return false
+	}
+	return C.iggMultiSelectIOGetNavIdSelected(io.handle()) != 0
+}
+
+// SetRangeSrcReset requests to reset the source item of the range selection, e.g. after deleting the selection.
+// Call it before EndMultiSelect() on the value returned by BeginMultiSelect().
+func (io MultiSelectIO) SetRangeSrcReset(value bool) {
+	if io == 0 {
+		return
+	}
+	C.iggMultiSelectIOSetRangeSrcReset(io.handle(), castBool(value))
+}
+
+// ItemsCount returns the itemsCount parameter of BeginMultiSelect().
+func (io MultiSelectIO) ItemsCount() int {
+	if io == 0 {
+		return 0
+	}
+	return int(C.iggMultiSelectIOGetItemsCount(io.handle()))
+}
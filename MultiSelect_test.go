@@ -0,0 +1,102 @@
+package imgui_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jetsetilly/imgui-go/v5"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type multiSelectList struct {
+	keys      []string
+	selection *imgui.SelectionStorage[string]
+	centers   map[int]imgui.Vec2
+	toggled   []int
+}
+
+func newMultiSelectList(count int) *multiSelectList {
+	list := &multiSelectList{centers: make(map[int]imgui.Vec2)}
+	for i := 0; i < count; i++ {
+		list.keys = append(list.keys, fmt.Sprintf("item-%d", i))
+	}
+	list.selection = imgui.NewSelectionStorage(func(index int) string { return list.keys[index] })
+	return list
+}
+
+func (list *multiSelectList) frame() {
+	imgui.SetNextWindowPos(imgui.Vec2{})
+	imgui.SetNextWindowSize(imgui.Vec2{X: 200, Y: 400})
+	imgui.Begin("List")
+	io := imgui.BeginMultiSelect(imgui.MultiSelectFlagsNone, list.selection.Size(), len(list.keys))
+	list.selection.ApplyRequests(io)
+	imgui.ListClipperAll(len(list.keys), func(i int) {
+		imgui.SetNextItemSelectionUserData(imgui.SelectionUserData(i))
+		imgui.SelectableV(list.keys[i], list.selection.Contains(list.keys[i]), 0, imgui.Vec2{})
+		if imgui.IsItemToggledSelection() {
+			list.toggled = append(list.toggled, i)
+		}
+		min, max := imgui.ItemRectMin(), imgui.ItemRectMax()
+		list.centers[i] = imgui.Vec2{X: (min.X + max.X) / 2, Y: (min.Y + max.Y) / 2}
+	})
+	list.selection.ApplyRequests(imgui.EndMultiSelect())
+	imgui.End()
+}
+
+func TestMultiSelectAppliesClickAndShiftClickToSelectionStorage(t *testing.T) {
+	newTestContext(t)
+
+	io := imgui.CurrentIO()
+
+	list := newMultiSelectList(10)
+	step := func() {
+		imgui.NewFrame()
+		list.frame()
+		imgui.Render()
+	}
+	click := func(index int) {
+		io.SetMousePosition(list.centers[index])
+		step()
+		io.SetMouseButtonDown(0, true)
+		step()
+		io.SetMouseButtonDown(0, false)
+		step()
+	}
+
+	step()
+	step()
+
+	click(2)
+	assert.Equal(t, []string{"item-2"}, list.selection.Items())
+	assert.Contains(t, list.toggled, 2)
+
+	io.AddKeyEvent(imgui.KeyModShift, true)
+	click(5)
+	io.AddKeyEvent(imgui.KeyModShift, false)
+	step()
+	assert.Equal(t, 4, list.selection.Size())
+	for i := 2; i <= 5; i++ {
+		assert.True(t, list.selection.Contains(list.keys[i]), "item %d should be selected", i)
+	}
+	assert.False(t, list.selection.Contains(list.keys[6]))
+
+	click(7)
+	assert.Equal(t, []string{"item-7"}, list.selection.Items())
+}
+
+func TestSelectionStorageKeepsSelectionOrder(t *testing.T) {
+	selection := imgui.NewSelectionStorage(func(index int) int { return index * 10 })
+	selection.SetItemSelected(30, true)
+	selection.SetItemSelected(10, true)
+	selection.SetItemSelected(20, true)
+	selection.SetItemSelected(10, false)
+	selection.SetItemSelected(30, true)
+
+	assert.Equal(t, []int{30, 20}, selection.Items())
+	assert.True(t, selection.Contains(20))
+	assert.False(t, selection.Contains(10))
+
+	selection.Clear()
+	assert.Equal(t, 0, selection.Size())
+}
@@ -1,6 +1,7 @@
 package imgui
 
 // #include "wrapper/PlatformIO.h"
+// #include "wrapper/Context.h"
 import "C"
 
 // PlatformIO is the platform (SLD, GLFW, etc.) specific IO for Imgui. Access via CurrentPlatformIO().
@@ -21,35 +22,43 @@ type Clipboard interface {
 	SetClipboardText(value string)
 }
 
-var clipboard Clipboard
-var freeClipboardString func()
-
-// SetClipboard registers a clipboard for text copy/paste actions.
+// SetClipboard registers a clipboard for text copy/paste actions of the current context.
 // If no clipboard is set, then a fallback implementation may be used, if available for the OS.
 // To disable clipboard handling overall, pass nil as the Clipboard.
 //
 // Since ImGui queries the clipboard text via a return value, the wrapper has to hold the
 // current clipboard text as a copy in memory. This memory will be freed at the next clipboard operation.
 func (io PlatformIO) SetClipboard(board Clipboard) {
-	if freeClipboardString != nil {
-		freeClipboardString()
-		freeClipboardString = nil
-	}
+	state := currentState()
+	state.mutex.Lock()
+	state.releaseClipboardString()
+	state.clipboard = board
+	state.mutex.Unlock()
 	if board != nil {
-		clipboard = board
 		C.iggPlatformIoRegisterClipboardFunctions(io.handle)
 	} else {
-		clipboard = nil
 		C.iggPlatformIoClearClipboardFunctions(io.handle)
 	}
 }
 
-//export iggPlatformIoGetClipboardText
-func iggPlatformIoGetClipboardText(_ C.IggPlatformIO) *C.char {
-	if freeClipboardString != nil {
-		freeClipboardString()
-		freeClipboardString = nil
+// releaseClipboardString frees the copy of the last clipboard text. The caller must hold the mutex of the state.
+func (state *contextState) releaseClipboardString() {
+	if state.freeClipboardString != nil {
+		state.freeClipboardString()
+		state.freeClipboardString = nil
 	}
+}
+
+// The native callbacks receive the context as the handle, see wrapper/PlatformIO.cpp.
+// The clipboard is called without holding the mutex of the state, so that it may use the state as well.
+
+//export iggPlatformIoGetClipboardText
+func iggPlatformIoGetClipboardText(handle C.IggPlatformIO) *C.char {
+	state := stateFor(C.IggContext(handle))
+	state.mutex.Lock()
+	state.releaseClipboardString()
+	clipboard := state.clipboard
+	state.mutex.Unlock()
 	if clipboard == nil {
 		return nil
 	}
@@ -58,16 +67,20 @@ func iggPlatformIoGetClipboardText(_ C.IggPlatformIO) *C.char {
 		return nil
 	}
 	textPtr, textFin := wrapString(text)
-	freeClipboardString = textFin
+	state.mutex.Lock()
+	state.releaseClipboardString()
+	state.freeClipboardString = textFin
+	state.mutex.Unlock()
 	return textPtr
 }
 
 //export iggPlatformIoSetClipboardText
-func iggPlatformIoSetClipboardText(_ C.IggPlatformIO, text *C.char) {
-	if freeClipboardString != nil {
-		freeClipboardString()
-		freeClipboardString = nil
-	}
+func iggPlatformIoSetClipboardText(handle C.IggPlatformIO, text *C.char) {
+	state := stateFor(C.IggContext(handle))
+	state.mutex.Lock()
+	state.releaseClipboardString()
+	clipboard := state.clipboard
+	state.mutex.Unlock()
 	if clipboard == nil {
 		return
 	}
@@ -35,7 +35,7 @@ const (
 func BeginPopupV(name string, flags WindowFlags) bool {
 	nameArg, nameFin := wrapString(name)
 	defer nameFin()
-	return C.iggBeginPopup(nameArg, C.int(flags)) != 0
+	return accessiblePopup(name, C.iggBeginPopup(nameArg, C.int(flags)) != 0)
 }
 
 // BeginPopup calls BeginPopupV(name, nil, 0).
@@ -51,7 +51,7 @@ func BeginPopupModalV(name string, open *bool, flags WindowFlags) bool {
 	defer nameFin()
 	openArg, openFin := wrapBool(open)
 	defer openFin()
-	return C.iggBeginPopupModal(nameArg, openArg, C.int(flags)) != 0
+	return accessiblePopup(name, C.iggBeginPopupModal(nameArg, openArg, C.int(flags)) != 0)
 }
 
 // BeginPopupModal calls BeginPopupModalV(name, nil, 0).
@@ -61,6 +61,7 @@ func BeginPopupModal(name string) bool {
 
 // EndPopup finishes a popup. Only call EndPopup() if BeginPopupXXX() returns true!
 func EndPopup() {
+	accessibleEnd(AccessibilityRolePopup)
 	C.iggEndPopup()
 }
 
@@ -102,7 +103,7 @@ func CloseCurrentPopup() {
 func BeginPopupContextItemV(id string, flags PopupFlags) bool {
 	idArg, idFin := wrapString(id)
 	defer idFin()
-	return C.iggBeginPopupContextItem(idArg, C.int(flags)) != 0
+	return accessiblePopup(id, C.iggBeginPopupContextItem(idArg, C.int(flags)) != 0)
 }
 
 // BeginPopupContextItem calls BeginPopupContextItemV("", PopupFlagsMouseButtonRight).
@@ -114,7 +115,7 @@ func BeginPopupContextItem() bool {
 func BeginPopupContextWindowV(id string, flags PopupFlags) bool {
 	idArg, idFin := wrapString(id)
 	defer idFin()
-	return C.iggBeginPopupContextWindow(idArg, C.int(flags)) != 0
+	return accessiblePopup(id, C.iggBeginPopupContextWindow(idArg, C.int(flags)) != 0)
 }
 
 // BeginPopupContextWindow calls BeginPopupContextWindowV("", PopupFlagsMouseButtonRight).
@@ -126,7 +127,7 @@ func BeginPopupContextWindow() bool {
 func BeginPopupContextVoidV(id string, flags PopupFlags) bool {
 	idArg, idFin := wrapString(id)
 	defer idFin()
-	return C.iggBeginPopupContextVoid(idArg, C.int(flags)) != 0
+	return accessiblePopup(id, C.iggBeginPopupContextVoid(idArg, C.int(flags)) != 0)
 }
 
 // BeginPopupContextVoid calls BeginPopupContextVoidV("", PopupFlagsMouseButtonRight).
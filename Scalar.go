@@ -0,0 +1,194 @@
+package imgui
+
+// #include "wrapper/Widgets.h"
+import "C"
+
+import (
+	"math/bits"
+	"unsafe"
+)
+
+// DataType identifies the type of the values of the scalar widgets.
+type DataType int
+
+// This is the list of DataType identifier.
+const (
+	DataTypeS8     DataType = 0 // signed char / char (with sensible compilers)
+	DataTypeU8     DataType = 1 // unsigned char
+	DataTypeS16    DataType = 2 // short
+	DataTypeU16    DataType = 3 // unsigned short
+	DataTypeS32    DataType = 4 // int
+	DataTypeU32    DataType = 5 // unsigned int
+	DataTypeS64    DataType = 6 // long long / __int64
+	DataTypeU64    DataType = 7 // unsigned long long / unsigned __int64
+	DataTypeFloat  DataType = 8 // float
+	DataTypeDouble DataType = 9 // double
+)
+
+// Scalar is the set of types the scalar widgets can edit.
+// Types with these underlying types, such as a "type Register uint8", are supported as well.
+// int and uint are mapped to the data type of their size.
+type Scalar interface {
+	~int8 | ~uint8 | ~int16 | ~uint16 | ~int32 | ~uint32 | ~int64 | ~uint64 | ~int | ~uint | ~float32 | ~float64
+}
+
+// DataTypeOf returns the DataType the scalar widgets use for values of type T.
+func DataTypeOf[T Scalar]() DataType {
+	switch any(*new(T)).(type) {
+	case int8:
+		return DataTypeS8
+	case uint8:
+		return DataTypeU8
+	case int16:
+		return DataTypeS16
+	case uint16:
+		return DataTypeU16
+	case int32:
+		return DataTypeS32
+	case uint32:
+		return DataTypeU32
+	case int64:
+		return DataTypeS64
+	case uint64:
+		return DataTypeU64
+	case float32:
+		return DataTypeFloat
+	case float64:
+		return DataTypeDouble
+	}
+
+	// int, uint and defined types, such as "type Register uint8", are identified by the size
+	// and the arithmetic of their underlying type.
+	// The integer data types are ordered by size, with the unsigned type following the signed one.
+	var zero T
+	one := T(1)
+	size := unsafe.Sizeof(zero)
+	switch {
+	case one/2 != zero:
+		if size == 4 {
+			return DataTypeFloat
+		}
+		return DataTypeDouble
+	case zero-one > zero:
+		return DataTypeU8 + DataType(2*bits.TrailingZeros(uint(size)))
+	default:
+		return DataTypeS8 + DataType(2*bits.TrailingZeros(uint(size)))
+	}
+}
+
+// wrapFormat returns nil for an empty format, which selects the default format of the data type.
+func wrapFormat(format string) (wrapped *C.char, finisher func()) {
+	if format == "" {
+		return nil, func() {}
+	}
+	return wrapString(format)
+}
+
+// DragScalarV creates a draggable slider for a value of any numeric type.
+// The value is clamped to [min, max], unless both are zero.
+// An empty format selects the default format of the type, such as "%d" or "%.3f".
+func DragScalarV[T Scalar](label string, value *T, speed float32, min, max T, format string, flags SliderFlags) bool {
+	return DragScalarNV(label, unsafe.Slice(value, 1), speed, min, max, format, flags)
+}
+
+// DragScalar calls DragScalarV(label, value, 1.0, 0, 0, "", SliderFlagsNone).
+func DragScalar[T Scalar](label string, value *T) bool {
+	return DragScalarV(label, value, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// DragScalarNV creates a draggable slider for each of the values, on one line.
+func DragScalarNV[T Scalar](label string, values []T, speed float32, min, max T, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
+	formatArg, formatFin := wrapFormat(format)
+	defer formatFin()
+	changed := C.iggDragScalarN(labelArg, C.int(DataTypeOf[T]()), unsafe.Pointer(&values[0]), C.int(len(values)),
+		C.float(speed), unsafe.Pointer(&min), unsafe.Pointer(&max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values...)
+	}
+	return changed
+}
+
+// DragScalarN calls DragScalarNV(label, values, 1.0, 0, 0, "", SliderFlagsNone).
+func DragScalarN[T Scalar](label string, values []T) bool {
+	return DragScalarNV(label, values, 1.0, 0, 0, "", SliderFlagsNone)
+}
+
+// SliderScalarV creates a slider for a value of any numeric type.
+// An empty format selects the default format of the type, such as "%d" or "%.3f".
+func SliderScalarV[T Scalar](label string, value *T, min, max T, format string, flags SliderFlags) bool {
+	return SliderScalarNV(label, unsafe.Slice(value, 1), min, max, format, flags)
+}
+
+// SliderScalar calls SliderScalarV(label, value, min, max, "", SliderFlagsNone).
+func SliderScalar[T Scalar](label string, value *T, min, max T) bool {
+	return SliderScalarV(label, value, min, max, "", SliderFlagsNone)
+}
+
+// SliderScalarNV creates a slider for each of the values, on one line.
+func SliderScalarNV[T Scalar](label string, values []T, min, max T, format string, flags SliderFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
+	formatArg, formatFin := wrapFormat(format)
+	defer formatFin()
+	changed := C.iggSliderScalarN(labelArg, C.int(DataTypeOf[T]()), unsafe.Pointer(&values[0]), C.int(len(values)),
+		unsafe.Pointer(&min), unsafe.Pointer(&max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values...)
+	}
+	return changed
+}
+
+// SliderScalarN calls SliderScalarNV(label, values, min, max, "", SliderFlagsNone).
+func SliderScalarN[T Scalar](label string, values []T, min, max T) bool {
+	return SliderScalarNV(label, values, min, max, "", SliderFlagsNone)
+}
+
+// InputScalarV creates an input field for a value of any numeric type.
+// Values entered as text are clamped to the range of the type.
+// A step of zero hides the +/- buttons; stepFast is used while CTRL is held.
+// An empty format selects the default format of the type, such as "%d" or "%.3f".
+func InputScalarV[T Scalar](label string, value *T, step, stepFast T, format string, flags InputTextFlags) bool {
+	return InputScalarNV(label, unsafe.Slice(value, 1), step, stepFast, format, flags)
+}
+
+// InputScalar calls InputScalarV(label, value, 0, 0, "", 0).
+func InputScalar[T Scalar](label string, value *T) bool {
+	return InputScalarV(label, value, 0, 0, "", 0)
+}
+
+// InputScalarNV creates an input field for each of the values, on one line.
+func InputScalarNV[T Scalar](label string, values []T, step, stepFast T, format string, flags InputTextFlags) bool {
+	if len(values) == 0 {
+		return false
+	}
+	labelArg, labelFin := wrapString(label)
+	defer labelFin()
+	formatArg, formatFin := wrapFormat(format)
+	defer formatFin()
+	var stepArg, stepFastArg unsafe.Pointer
+	if step != 0 {
+		stepArg = unsafe.Pointer(&step)
+	}
+	if stepFast != 0 {
+		stepFastArg = unsafe.Pointer(&stepFast)
+	}
+	changed := C.iggInputScalarN(labelArg, C.int(DataTypeOf[T]()), unsafe.Pointer(&values[0]), C.int(len(values)),
+		stepArg, stepFastArg, formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSpinButton, label); node != nil {
+		node.Value = accessibleValue(values...)
+	}
+	return changed
+}
+
+// InputScalarN calls InputScalarNV(label, values, 0, 0, "", 0).
+func InputScalarN[T Scalar](label string, values []T) bool {
+	return InputScalarNV(label, values, 0, 0, "", 0)
+}
@@ -0,0 +1,78 @@
+package imgui_test
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go/v5"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	register uint8
+	offset   int16
+	cycles   float64
+)
+
+func TestDataTypeOfMapsGoTypes(t *testing.T) {
+	assert.Equal(t, imgui.DataTypeS8, imgui.DataTypeOf[int8]())
+	assert.Equal(t, imgui.DataTypeU8, imgui.DataTypeOf[uint8]())
+	assert.Equal(t, imgui.DataTypeU8, imgui.DataTypeOf[register]())
+	assert.Equal(t, imgui.DataTypeS16, imgui.DataTypeOf[int16]())
+	assert.Equal(t, imgui.DataTypeU16, imgui.DataTypeOf[uint16]())
+	assert.Equal(t, imgui.DataTypeS32, imgui.DataTypeOf[int32]())
+	assert.Equal(t, imgui.DataTypeU32, imgui.DataTypeOf[uint32]())
+	assert.Equal(t, imgui.DataTypeS64, imgui.DataTypeOf[int64]())
+	assert.Equal(t, imgui.DataTypeU64, imgui.DataTypeOf[uint64]())
+	assert.Equal(t, imgui.DataTypeFloat, imgui.DataTypeOf[float32]())
+	assert.Equal(t, imgui.DataTypeDouble, imgui.DataTypeOf[float64]())
+	assert.Equal(t, imgui.DataTypeS16, imgui.DataTypeOf[offset]())
+	assert.Equal(t, imgui.DataTypeDouble, imgui.DataTypeOf[cycles]())
+	if unsafe.Sizeof(0) == 8 {
+		assert.Equal(t, imgui.DataTypeS64, imgui.DataTypeOf[int]())
+		assert.Equal(t, imgui.DataTypeU64, imgui.DataTypeOf[uint]())
+	} else {
+		assert.Equal(t, imgui.DataTypeS32, imgui.DataTypeOf[int]())
+		assert.Equal(t, imgui.DataTypeU32, imgui.DataTypeOf[uint]())
+	}
+}
+
+func TestInputScalarClampsToRangeOfType(t *testing.T) {
+	newTestContext(t)
+
+	io := imgui.CurrentIO()
+
+	var value register = 12
+	address := []uint16{0x1000, 0xFFFF}
+	var changed bool
+	step := func(focus bool) {
+		imgui.NewFrame()
+		imgui.Begin("Registers")
+		if focus {
+			imgui.SetKeyboardFocusHere()
+		}
+		changed = imgui.InputScalar("A", &value) || changed
+		imgui.DragScalarN("Address", address)
+		imgui.SliderScalarN("Bounds", address, 0, 0xFFFF)
+		imgui.End()
+		imgui.Render()
+	}
+
+	step(true)
+	io.AddKeyEvent(imgui.KeyModCtrl, true)
+	io.AddKeyEvent(imgui.KeyA, true)
+	step(false)
+	io.AddKeyEvent(imgui.KeyA, false)
+	io.AddKeyEvent(imgui.KeyModCtrl, false)
+	io.AddInputCharacters("300")
+	step(false)
+	io.AddKeyEvent(imgui.KeyEnter, true)
+	step(false)
+	io.AddKeyEvent(imgui.KeyEnter, false)
+	step(false)
+
+	assert.True(t, changed, "value should be reported as changed")
+	assert.Equal(t, register(255), value)
+	assert.Equal(t, []uint16{0x1000, 0xFFFF}, address)
+}
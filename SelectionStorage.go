@@ -0,0 +1,96 @@
+package imgui
+
+import "sort"
+
+// SelectionStorage stores the state of a multi-selection as a set of keys, and applies the requests
+// of BeginMultiSelect() and EndMultiSelect() to it. It is the equivalent of ImGuiSelectionBasicStorage.
+//
+// Requests refer to items by their index, as passed to SetNextItemSelectionUserData().
+// The storage converts these indices to keys of the application, so the selection
+// stays valid when the items are sorted or filtered:
+//
+//	selection := imgui.NewSelectionStorage(func(index int) string { return files[index].Path })
+//
+//	io := imgui.BeginMultiSelect(imgui.MultiSelectFlagsBoxSelect1d, selection.Size(), len(files))
+//	selection.ApplyRequests(io)
+//	imgui.ListClipperAll(len(files), func(i int) {
+//		imgui.SetNextItemSelectionUserData(imgui.SelectionUserData(i))
+//		imgui.SelectableV(files[i].Name, selection.Contains(files[i].Path), 0, imgui.Vec2{})
+//	})
+//	selection.ApplyRequests(imgui.EndMultiSelect())
+type SelectionStorage[K comparable] struct {
+	indexToKey func(index int) K
+	selected   map[K]int
+	order      int
+}
+
+// NewSelectionStorage returns an empty selection storage.
+// indexToKey converts the index of an item to its key.
+func NewSelectionStorage[K comparable](indexToKey func(index int) K) *SelectionStorage[K] {
+	return &SelectionStorage[K]{
+		indexToKey: indexToKey,
+		selected:   make(map[K]int),
+	}
+}
+
+// ApplyRequests applies the selection requests of the given MultiSelectIO.
+// Selecting all items requires the itemsCount parameter of BeginMultiSelect().
+func (storage *SelectionStorage[K]) ApplyRequests(io MultiSelectIO) {
+	itemsCount := io.ItemsCount()
+	for _, request := range io.Requests() {
+		switch request.Type {
+		case SelectionRequestTypeSetAll:
+			storage.Clear()
+			if request.Selected {
+				for index := 0; index < itemsCount; index++ {
+					storage.SetItemSelected(storage.indexToKey(index), true)
+				}
+			}
+		case SelectionRequestTypeSetRange:
+			for index := request.RangeFirstItem; index <= request.RangeLastItem; index++ {
+				storage.SetItemSelected(storage.indexToKey(int(index)), request.Selected)
+			}
+		}
+	}
+}
+
+// Contains returns true if the item with given key is selected.
+func (storage *SelectionStorage[K]) Contains(key K) bool {
+	_, selected := storage.selected[key]
+	return selected
+}
+
+// Size returns the number of selected items.
+func (storage *SelectionStorage[K]) Size() int {
+	return len(storage.selected)
+}
+
+// Clear unselects all items.
+func (storage *SelectionStorage[K]) Clear() {
+	storage.selected = make(map[K]int)
+}
+
+// SetItemSelected adds or removes an item from the selection.
+// This is generally done by ApplyRequests().
+func (storage *SelectionStorage[K]) SetItemSelected(key K, selected bool) {
+	if !selected {
+		delete(storage.selected, key)
+		return
+	}
+	if _, known := storage.selected[key]; !known {
+		storage.selected[key] = storage.order
+		storage.order++
+	}
+}
+
+// Items returns the keys of the selected items, in the order they were selected.
+func (storage *SelectionStorage[K]) Items() []K {
+	items := make([]K, 0, len(storage.selected))
+	for key := range storage.selected {
+		items = append(items, key)
+	}
+	sort.Slice(items, func(a, b int) bool {
+		return storage.selected[items[a]] < storage.selected[items[b]]
+	})
+	return items
+}
@@ -178,26 +178,137 @@ func IsWindowHovered() bool {
 }
 
 // IsKeyDown returns true if the corresponding key is currently being held down.
-func IsKeyDown(key int) bool {
+func IsKeyDown(key ImguiKey) bool {
 	return C.iggIsKeyDown(C.int(key)) != 0
 }
 
 // IsKeyPressedV returns true if the corresponding key was pressed (went from !Down to Down).
 // If repeat=true and the key is being held down then the press is repeated using io.KeyRepeatDelay and KeyRepeatRate.
-func IsKeyPressedV(key int, repeat bool) bool {
+func IsKeyPressedV(key ImguiKey, repeat bool) bool {
 	return C.iggIsKeyPressed(C.int(key), castBool(repeat)) != 0
 }
 
 // IsKeyPressed calls IsKeyPressedV(key, true).
-func IsKeyPressed(key int) bool {
+func IsKeyPressed(key ImguiKey) bool {
 	return IsKeyPressedV(key, true)
 }
 
 // IsKeyReleased returns true if the corresponding key was released (went from Down to !Down).
-func IsKeyReleased(key int) bool {
+func IsKeyReleased(key ImguiKey) bool {
 	return C.iggIsKeyReleased(C.int(key)) != 0
 }
 
+// KeyChord is an ImguiKey optionally combined with one or more KeyMod values, such as Ctrl+S.
+type KeyChord int
+
+// NewKeyChord combines a key with the given modifiers, e.g. NewKeyChord(KeyS, KeyModCtrl).
+func NewKeyChord(key ImguiKey, mods ...ImguiKey) KeyChord {
+	chord := KeyChord(key)
+	for _, mod := range mods {
+		chord |= KeyChord(mod)
+	}
+	return chord
+}
+
+// keyModMask covers all KeyMod values.
+const keyModMask = KeyChord(KeyModCtrl | KeyModShift | KeyModAlt | KeyModSuper)
+
+// Key returns the key of the chord, without modifiers.
+func (chord KeyChord) Key() ImguiKey {
+	return ImguiKey(chord &^ keyModMask)
+}
+
+// Mods returns the modifiers of the chord.
+func (chord KeyChord) Mods() ImguiKey {
+	return ImguiKey(chord & keyModMask)
+}
+
+// InputFlags for ShortcutV() and SetNextItemShortcutV().
+type InputFlags int
+
+const (
+	// InputFlagsNone specifies the default behavior.
+	InputFlagsNone InputFlags = 0
+	// InputFlagsRepeat enables repeat. Return true on successive repeats.
+	InputFlagsRepeat InputFlags = 1 << 0
+
+	// Routing policies for Shortcut() + low-level SetShortcutRouting().
+	// The general idea is that several callers may register interest in a shortcut, and only one owner gets it.
+	// When a policy (except for InputFlagsRouteAlways *) is set, Shortcut() will register itself with SetShortcutRouting(),
+	// allowing ImGui to resolve ownership between several callers.
+
+	// InputFlagsRouteActive routes to the active item only.
+	InputFlagsRouteActive InputFlags = 1 << 10
+	// InputFlagsRouteFocused routes to windows in the focus stack (DEFAULT). Deep-most focused window takes inputs.
+	// Active item takes inputs over deep-most focused window.
+	InputFlagsRouteFocused InputFlags = 1 << 11
+	// InputFlagsRouteGlobal is a global route (unless a focused window or active item registered the route).
+	InputFlagsRouteGlobal InputFlags = 1 << 12
+	// InputFlagsRouteAlways does not register a route, and polls the keys directly.
+	InputFlagsRouteAlways InputFlags = 1 << 13
+	// InputFlagsRouteOverFocused is an option for the global route: higher priority than focused route
+	// (unless active item in focused route).
+	InputFlagsRouteOverFocused InputFlags = 1 << 14
+	// InputFlagsRouteOverActive is an option for the global route: higher priority than active item.
+	// Unlikely you need to use that: will interfere with every active items.
+	InputFlagsRouteOverActive InputFlags = 1 << 15
+	// InputFlagsRouteUnlessBgFocused is an option for the global route: will not be applied if underlying
+	// background/void is focused (== no Dear ImGui windows are focused). Useful for overlay applications.
+	InputFlagsRouteUnlessBgFocused InputFlags = 1 << 16
+	// InputFlagsRouteFromRootWindow is an option: route evaluated from the point of view of root window rather than current window.
+	InputFlagsRouteFromRootWindow InputFlags = 1 << 17
+
+	// InputFlagsTooltip automatically displays a tooltip when hovering the item. Only for SetNextItemShortcutV().
+	InputFlagsTooltip InputFlags = 1 << 18
+)
+
+// IsKeyChordPressed returns true if the key chord (mods + key) was pressed.
+// This doesn't do any routing or focus check, please consider using Shortcut() instead.
+func IsKeyChordPressed(chord KeyChord) bool {
+	return C.iggIsKeyChordPressed(C.int(chord)) != 0
+}
+
+// ShortcutV returns true if the key chord was pressed, and the shortcut is routed to the caller.
+// Several callers may register interest in the same shortcut; the routing policy in flags decides which one gets it.
+// By default, the deep-most focused window takes the shortcut.
+func ShortcutV(chord KeyChord, flags InputFlags) bool {
+	return C.iggShortcut(C.int(chord), C.int(flags)) != 0
+}
+
+// Shortcut calls ShortcutV(chord, 0).
+func Shortcut(chord KeyChord) bool {
+	return ShortcutV(chord, 0)
+}
+
+// SetNextItemShortcutV sets a shortcut for the next item, which activates it when pressed.
+func SetNextItemShortcutV(chord KeyChord, flags InputFlags) {
+	C.iggSetNextItemShortcut(C.int(chord), C.int(flags))
+}
+
+// SetNextItemShortcut calls SetNextItemShortcutV(chord, 0).
+func SetNextItemShortcut(chord KeyChord) {
+	SetNextItemShortcutV(chord, 0)
+}
+
+// SetItemKeyOwner sets the owner of the key to the last item, if it is hovered or active.
+// For example, calling SetItemKeyOwner(KeyMouseWheelY) after a button disables scrolling with the wheel
+// while the button is hovered.
+func SetItemKeyOwner(key ImguiKey) {
+	C.iggSetItemKeyOwner(C.int(key))
+}
+
+// GetKeyName returns the English name of the key.
+// The names are provided for debugging purposes and are not meant to be saved persistently nor compared.
+func GetKeyName(key ImguiKey) string {
+	return C.GoString(C.iggGetKeyName(C.int(key)))
+}
+
+// GetKeyChordName returns the English name of the key chord, such as "Ctrl+S".
+// Useful as shortcut text of a MenuItemV().
+func GetKeyChordName(chord KeyChord) string {
+	return C.GoString(C.iggGetKeyChordName(C.int(chord)))
+}
+
 // IsMouseDown returns true if the corresponding mouse button is currently being held down.
 func IsMouseDown(button int) bool {
 	return C.iggIsMouseDown(C.int(button)) != 0
@@ -314,3 +425,18 @@ func ItemRectMin() Vec2 {
 	valueFin()
 	return value
 }
+
+// ItemRectSize returns the size of the last item.
+func ItemRectSize() Vec2 {
+	var value Vec2
+	valueArg, valueFin := value.wrapped()
+	C.iggGetItemRectSize(valueArg)
+	valueFin()
+	return value
+}
+
+// GetItemID returns the ID of the last item. Many items have an ID, but not all:
+// Text() for example has none, and 0 is returned.
+func GetItemID() ID {
+	return ID(C.iggGetItemID())
+}
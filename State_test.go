@@ -0,0 +1,67 @@
+package imgui_test
+
+import (
+	"testing"
+
+	"github.com/jetsetilly/imgui-go/v5"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyChordCombinesKeyAndMods(t *testing.T) {
+	chord := imgui.NewKeyChord(imgui.KeyS, imgui.KeyModCtrl, imgui.KeyModShift)
+	assert.Equal(t, imgui.KeyS, chord.Key())
+	assert.Equal(t, imgui.KeyModCtrl|imgui.KeyModShift, chord.Mods())
+	assert.Equal(t, imgui.KeyChord(imgui.KeyModCtrl|imgui.KeyModShift|imgui.KeyS), chord)
+}
+
+func TestShortcutIsRoutedToFocusedWindow(t *testing.T) {
+	newTestContext(t)
+
+	io := imgui.CurrentIO()
+
+	save := imgui.NewKeyChord(imgui.KeyS, imgui.KeyModCtrl)
+	saved := make(map[string]int)
+	buttonPressed := 0
+	focusFirst := false
+	step := func() {
+		imgui.NewFrame()
+		for _, name := range []string{"First", "Second"} {
+			if focusFirst && (name == "First") {
+				imgui.SetNextWindowFocus()
+				focusFirst = false
+			}
+			imgui.Begin(name)
+			if imgui.Shortcut(save) {
+				saved[name]++
+			}
+			if name == "Second" {
+				imgui.SetNextItemShortcut(imgui.NewKeyChord(imgui.KeyB, imgui.KeyModCtrl))
+				if imgui.Button("Build") {
+					buttonPressed++
+				}
+			}
+			imgui.End()
+		}
+		imgui.Render()
+	}
+
+	step()
+	step()
+	focusFirst = true
+	step()
+	io.AddKeyEvent(imgui.KeyModCtrl, true)
+	io.AddKeyEvent(imgui.KeyS, true)
+	step()
+	io.AddKeyEvent(imgui.KeyS, false)
+	io.AddKeyEvent(imgui.KeyB, true)
+	step()
+	io.AddKeyEvent(imgui.KeyB, false)
+	io.AddKeyEvent(imgui.KeyModCtrl, false)
+	step()
+
+	assert.Equal(t, map[string]int{"First": 1}, saved)
+	assert.Equal(t, 0, buttonPressed, "shortcut of item in unfocused window should not trigger")
+	assert.Equal(t, "Ctrl+S", imgui.GetKeyChordName(save))
+	assert.Equal(t, "S", imgui.GetKeyName(imgui.KeyS))
+}
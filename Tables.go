@@ -219,7 +219,11 @@ func BeginTableV(id string, columnsCount int, flags TableFlags, outerSize Vec2,
 	idArg, idFin := wrapString(id)
 	defer idFin()
 	outerSizeArg, _ := outerSize.wrapped()
-	return C.iggBeginTable(idArg, C.int(columnsCount), C.int(flags), outerSizeArg, C.float(innerWidth)) != 0
+	open := C.iggBeginTable(idArg, C.int(columnsCount), C.int(flags), outerSizeArg, C.float(innerWidth)) != 0
+	if open {
+		accessibleScope(AccessibilityRoleTable, id, accessibilityBoundsFromItem)
+	}
+	return open
 }
 
 // BeginTable calls BeginTableV(id, columnsCount, 0, imgui.Vec2{}, 0.0).
@@ -231,11 +235,13 @@ func BeginTable(id string, columnsCount int) bool {
 // only call EndTable() if BeginTable() returns true!
 func EndTable() {
 	C.iggEndTable()
+	accessibleEnd(AccessibilityRoleTable)
 }
 
 // TableNextRowV appends into the first cell of a new row.
 func TableNextRowV(flags TableRowFlags, minRowHeight float32) {
 	C.iggTableNextRow(C.int(flags), C.float(minRowHeight))
+	accessibleTableRow()
 }
 
 // TableNextRow calls TableNextRowV(0, 0.0).
@@ -246,12 +252,16 @@ func TableNextRow() {
 // TableNextColumn appends into the next column (or first column of next row if currently in last column)
 // Return true when column is visible.
 func TableNextColumn() bool {
-	return C.iggTableNextColumn() != 0
+	visible := C.iggTableNextColumn() != 0
+	accessibleTableCell(AccessibilityRoleCell, -1, "")
+	return visible
 }
 
 // TableSetColumnIndex appends into the specified column. Return true when column is visible.
 func TableSetColumnIndex(columnN int) bool {
-	return C.iggTableSetColumnIndex(C.int(columnN)) != 0
+	visible := C.iggTableSetColumnIndex(C.int(columnN)) != 0
+	accessibleTableCell(AccessibilityRoleCell, columnN, "")
+	return visible
 }
 
 // TableSetupColumnV specify label, resizing policy, default width/weight, id, various other flags etc.
@@ -281,6 +291,11 @@ func TableSetupScrollFreeze(cols int, rows int) {
 // TableHeadersRow submits all headers cells based on data provided to TableSetupColumn() + submit context menu.
 func TableHeadersRow() {
 	C.iggTableHeadersRow()
+	if accessibleTableRow() != nil {
+		for column := 0; column < TableGetColumnCount(); column++ {
+			accessibleTableCell(AccessibilityRoleColumnHeader, column, TableGetColumnNameV(column))
+		}
+	}
 }
 
 // TableHeader submits one header cell manually (rarely used).
@@ -288,6 +303,7 @@ func TableHeader(label string) {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 	C.iggTableHeader(labelArg)
+	accessibleTableHeader(label)
 }
 
 // TableGetColumnCount returns number of columns (value passed to BeginTable).
@@ -16,6 +16,7 @@ func Text(text string) {
 	defer textFin()
 	// Internally we use ImGui::TextUnformatted, for the most direct call.
 	C.iggTextUnformatted(textArg)
+	accessibleItem(AccessibilityRoleText, text)
 }
 
 // Textf calls Text(fmt.Sprintf(format, v...) .
@@ -30,6 +31,9 @@ func LabelText(label, text string) {
 	textArg, textFin := wrapString(text)
 	defer textFin()
 	C.iggLabelText(labelArg, textArg)
+	if node := accessibleItem(AccessibilityRoleText, label); node != nil {
+		node.Value = text
+	}
 }
 
 // LabelTextf calls LabelText(label, fmt.Sprintf(format, v...)) .
@@ -42,7 +46,9 @@ func ButtonV(id string, size Vec2) bool {
 	idArg, idFin := wrapString(id)
 	defer idFin()
 	sizeArg, _ := size.wrapped()
-	return C.iggButton(idArg, sizeArg) != 0
+	clicked := C.iggButton(idArg, sizeArg) != 0
+	accessibleItem(AccessibilityRoleButton, id)
+	return clicked
 }
 
 // Button calls ButtonV(id, Vec2{0,0}).
@@ -67,7 +73,9 @@ func InvisibleButtonV(id string, size Vec2, flags ButtonFlags) bool {
 	idArg, idFin := wrapString(id)
 	defer idFin()
 	sizeArg, _ := size.wrapped()
-	return C.iggInvisibleButton(idArg, sizeArg, C.int(flags)) != 0
+	clicked := C.iggInvisibleButton(idArg, sizeArg, C.int(flags)) != 0
+	accessibleItem(AccessibilityRoleButton, id)
+	return clicked
 }
 
 // InvisibleButton calls InvisibleButtonV(id, size, ButtonFlagsNone).
@@ -82,6 +90,7 @@ func ImageV(id TextureID, size Vec2, uv0, uv1 Vec2) {
 	uv0Arg, _ := uv0.wrapped()
 	uv1Arg, _ := uv1.wrapped()
 	C.iggImage(id.handle(), sizeArg, uv0Arg, uv1Arg)
+	accessibleItem(AccessibilityRoleImage, "")
 }
 
 // Image calls ImageV(id, size, Vec2{0,0}, Vec2{1,1}).
@@ -98,6 +107,7 @@ func ImageWithBgV(id TextureID, size Vec2, uv0, uv1 Vec2, tintCol, borderCol Vec
 	tintColArg, _ := tintCol.wrapped()
 	borderColArg, _ := borderCol.wrapped()
 	C.iggImageWithBg(id.handle(), sizeArg, uv0Arg, uv1Arg, tintColArg, borderColArg)
+	accessibleItem(AccessibilityRoleImage, "")
 }
 
 // ImageWithBg calls ImageWithBgV(id, size, Vec2{0,0}, Vec2{1,1}, Vec4{1,1,1,1}, Vec4{0,0,0,0}).
@@ -115,7 +125,9 @@ func ImageButtonV(strId string, id TextureID, size Vec2, uv0, uv1 Vec2, bgCol Ve
 	uv1Arg, _ := uv1.wrapped()
 	bgColArg, _ := bgCol.wrapped()
 	tintColArg, _ := tintCol.wrapped()
-	return C.iggImageButton(strIdArg, id.handle(), sizeArg, uv0Arg, uv1Arg, bgColArg, tintColArg) != 0
+	clicked := C.iggImageButton(strIdArg, id.handle(), sizeArg, uv0Arg, uv1Arg, bgColArg, tintColArg) != 0
+	accessibleItem(AccessibilityRoleButton, strId)
+	return clicked
 }
 
 // ImageButton calls ImageButtonV(id, size, Vec2{0,0}, Vec2{1,1}, -1, Vec4{0,0,0,0}, Vec4{1,1,1,1}).
@@ -130,14 +142,22 @@ func Checkbox(id string, selected *bool) bool {
 	defer idFin()
 	selectedArg, selectedFin := wrapBool(selected)
 	defer selectedFin()
-	return C.iggCheckbox(idArg, selectedArg) != 0
+	changed := C.iggCheckbox(idArg, selectedArg) != 0
+	if node := accessibleItem(AccessibilityRoleCheckbox, id); node != nil {
+		node.Checked = accessibleFlag(*selectedArg != 0)
+	}
+	return changed
 }
 
 // RadioButton returns true if it is clicked and active indicates if it is selected.
 func RadioButton(id string, active bool) bool {
 	idArg, idFin := wrapString(id)
 	defer idFin()
-	return C.iggRadioButton(idArg, castBool(active)) != 0
+	clicked := C.iggRadioButton(idArg, castBool(active)) != 0
+	if node := accessibleItem(AccessibilityRoleRadioButton, id); node != nil {
+		node.Checked = accessibleFlag(active)
+	}
+	return clicked
 }
 
 // RadioButtonInt modifies integer v. Returns true if it is selected.
@@ -153,6 +173,9 @@ func RadioButtonInt(id string, v *int, button int) bool {
 	idArg, idFin := wrapString(id)
 	defer idFin()
 	ok := C.iggRadioButton(idArg, castBool(button == *v)) != 0
+	if node := accessibleItem(AccessibilityRoleRadioButton, id); node != nil {
+		node.Checked = accessibleFlag(button == *v)
+	}
 	if ok {
 		*v = button
 	}
@@ -172,6 +195,9 @@ func ProgressBarV(fraction float32, size Vec2, overlay string) {
 	overlayArg, overlayFin := wrapString(overlay)
 	defer overlayFin()
 	C.iggProgressBar(C.float(fraction), sizeArg, overlayArg)
+	if node := accessibleItem(AccessibilityRoleProgressBar, overlay); node != nil {
+		node.Value = accessibleValue(fraction)
+	}
 }
 
 // ProgressBar calls ProgressBarV(fraction, Vec2{X: -math.SmallestNonzeroFloat32, Y: 0}, "").
@@ -202,7 +228,12 @@ func BeginComboV(label, previewValue string, flags ComboFlags) bool {
 	defer labelFin()
 	previewValueArg, previewValueFin := wrapString(previewValue)
 	defer previewValueFin()
-	return C.iggBeginCombo(labelArg, previewValueArg, C.int(flags)) != 0
+	open := C.iggBeginCombo(labelArg, previewValueArg, C.int(flags)) != 0
+	if node := accessibleContainer(AccessibilityRoleComboBox, label, open); node != nil {
+		node.Value = previewValue
+		node.Expanded = accessibleFlag(open)
+	}
+	return open
 }
 
 // BeginCombo calls BeginComboV(label, previewValue, 0).
@@ -212,6 +243,7 @@ func BeginCombo(label, previewValue string) bool {
 
 // EndCombo must be called if BeginComboV() returned true.
 func EndCombo() {
+	accessibleEnd(AccessibilityRoleComboBox)
 	C.iggEndCombo()
 }
 
@@ -225,12 +257,16 @@ func Combo(id string, value *int32, list []string) bool {
 func ComboV(id string, value *int32, list []string, heightInItems int) bool {
 	valueArg, valueFin := wrapInt32(value)
 	defer valueFin()
-	return C.iggCombo(
+	changed := C.iggCombo(
 		C.CString(id),
 		valueArg,
 		C.CString(strings.Join(list, string(byte(0)))+string(byte(0))),
 		(C.int)(heightInItems),
 	) != 0
+	if node := accessibleItem(AccessibilityRoleComboBox, id); node != nil {
+		node.Value = accessibleItemText(list, *valueArg)
+	}
+	return changed
 }
 
 // SliderFlags for DragFloat(), DragInt(), SliderFloat(), SliderInt() etc.
@@ -257,7 +293,11 @@ func DragFloatV(label string, value *float32, speed, min, max float32, format st
 	defer valueFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
-	return C.iggDragFloat(labelArg, valueArg, C.float(speed), C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	changed := C.iggDragFloat(labelArg, valueArg, C.float(speed), C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(float32(*valueArg))
+	}
+	return changed
 }
 
 // DragFloat calls DragFloatV(label, value, 1.0, 0.0, 0.0, "%.3f", SliderFlagsNone).
@@ -272,7 +312,11 @@ func DragFloat2V(label string, values *[2]float32, speed, min, max float32, form
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 	cvalues := (*C.float)(&values[0])
-	return C.iggDragFloatN(labelArg, cvalues, 2, C.float(speed), C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	changed := C.iggDragFloatN(labelArg, cvalues, 2, C.float(speed), C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values[:]...)
+	}
+	return changed
 }
 
 // DragFloat2 calls DragFloat2V(label, value, 1.0, 0.0, 0.0, "%.3f", SliderFlagsNone).
@@ -287,7 +331,11 @@ func DragFloat3V(label string, values *[3]float32, speed, min, max float32, form
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 	cvalues := (*C.float)(&values[0])
-	return C.iggDragFloatN(labelArg, cvalues, 3, C.float(speed), C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	changed := C.iggDragFloatN(labelArg, cvalues, 3, C.float(speed), C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values[:]...)
+	}
+	return changed
 }
 
 // DragFloat3 calls DragFloat3V(label, value, 1.0, 0.0, 0.0, "%.3f", SliderFlagsNone).
@@ -302,7 +350,11 @@ func DragFloat4V(label string, values *[4]float32, speed, min, max float32, form
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 	cvalues := (*C.float)(&values[0])
-	return C.iggDragFloatN(labelArg, cvalues, 4, C.float(speed), C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	changed := C.iggDragFloatN(labelArg, cvalues, 4, C.float(speed), C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values[:]...)
+	}
+	return changed
 }
 
 // DragFloat4 calls DragFloat4V(label, value, 1.0, 0.0, 0.0, "%.3f", SliderFlagsNone).
@@ -322,7 +374,11 @@ func DragFloatRange2V(label string, currentMin *float32, currentMax *float32, sp
 	defer formatFin()
 	formatMaxArg, formatMaxFin := wrapString(formatMax)
 	defer formatMaxFin()
-	return C.iggDragFloatRange2V(labelArg, currentMinArg, currentMaxArg, C.float(speed), C.float(min), C.float(max), formatArg, formatMaxArg, C.int(flags)) != 0
+	changed := C.iggDragFloatRange2V(labelArg, currentMinArg, currentMaxArg, C.float(speed), C.float(min), C.float(max), formatArg, formatMaxArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(float32(*currentMinArg), float32(*currentMaxArg))
+	}
+	return changed
 }
 
 // DragFloatRange2 calls DragFloatRange2V(label, currentMin, currentMax, 1, 0, 0, "%.3f", "%.3f", SliderFlagsNone).
@@ -338,7 +394,11 @@ func DragIntV(label string, value *int32, speed float32, min, max int32, format
 	defer valueFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
-	return C.iggDragInt(labelArg, valueArg, C.float(speed), C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	changed := C.iggDragInt(labelArg, valueArg, C.float(speed), C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(int32(*valueArg))
+	}
+	return changed
 }
 
 // DragInt calls DragIntV(label, value, 1.0, 0, 0, "%d", SliderFlagsNone).
@@ -353,7 +413,11 @@ func DragInt2V(label string, values *[2]int32, speed float32, min, max int32, fo
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 	cvalues := (*C.int)(&values[0])
-	return C.iggDragIntN(labelArg, cvalues, 2, C.float(speed), C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	changed := C.iggDragIntN(labelArg, cvalues, 2, C.float(speed), C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values[:]...)
+	}
+	return changed
 }
 
 // DragInt2 calls DragInt2V(label, value, 1.0, 0.0, 0.0, "%d", SliderFlagsNone).
@@ -368,7 +432,11 @@ func DragInt3V(label string, values *[3]int32, speed float32, min, max int32, fo
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 	cvalues := (*C.int)(&values[0])
-	return C.iggDragIntN(labelArg, cvalues, 3, C.float(speed), C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	changed := C.iggDragIntN(labelArg, cvalues, 3, C.float(speed), C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values[:]...)
+	}
+	return changed
 }
 
 // DragInt3 calls DragInt3V(label, value, 1.0, 0.0, 0.0, "%d", SliderFlagsNone).
@@ -383,7 +451,11 @@ func DragInt4V(label string, values *[4]int32, speed float32, min, max int32, fo
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 	cvalues := (*C.int)(&values[0])
-	return C.iggDragIntN(labelArg, cvalues, 4, C.float(speed), C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	changed := C.iggDragIntN(labelArg, cvalues, 4, C.float(speed), C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values[:]...)
+	}
+	return changed
 }
 
 // DragInt4 calls DragInt4V(label, value, 1.0, 0.0, 0.0, "%d", SliderFlagsNone).
@@ -392,7 +464,7 @@ func DragInt4(label string, value *[4]int32) bool {
 }
 
 // DragIntRange2V creates a draggable slider in ints range.
-func DragIntRange2V(label string, currentMin *int32, currentMax *int32, speed float32, min int, max int, format string, formatMax string, flags SliderFlags) bool {
+func DragIntRange2V(label string, currentMin *int32, currentMax *int32, speed float32, min, max int32, format string, formatMax string, flags SliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 	currentMinArg, currentMinFin := wrapInt32(currentMin)
@@ -403,7 +475,11 @@ func DragIntRange2V(label string, currentMin *int32, currentMax *int32, speed fl
 	defer formatFin()
 	formatMaxArg, formatMaxFin := wrapString(formatMax)
 	defer formatMaxFin()
-	return C.iggDragIntRange2V(labelArg, currentMinArg, currentMaxArg, C.float(speed), C.int(min), C.int(max), formatArg, formatMaxArg, C.int(flags)) != 0
+	changed := C.iggDragIntRange2V(labelArg, currentMinArg, currentMaxArg, C.float(speed), C.int(min), C.int(max), formatArg, formatMaxArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(int32(*currentMinArg), int32(*currentMaxArg))
+	}
+	return changed
 }
 
 // DragIntRange2 calls DragIntRange2V(label, currentMin, currentMax, 1, 0, 0, "%d", "%d", SliderFlagsNone).
@@ -419,7 +495,11 @@ func SliderFloatV(label string, value *float32, min, max float32, format string,
 	defer valueFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
-	return C.iggSliderFloat(labelArg, valueArg, C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	changed := C.iggSliderFloat(labelArg, valueArg, C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(float32(*valueArg))
+	}
+	return changed
 }
 
 // SliderFloat calls SliderIntV(label, value, min, max, "%.3f", SliderFlagsNone).
@@ -434,7 +514,11 @@ func SliderFloat2V(label string, values *[2]float32, min, max float32, format st
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 	cvalues := (*C.float)(&values[0])
-	return C.iggSliderFloatN(labelArg, cvalues, 2, C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	changed := C.iggSliderFloatN(labelArg, cvalues, 2, C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values[:]...)
+	}
+	return changed
 }
 
 // SliderFloat2 calls SliderFloat2V(label, values, min, max, "%.3f", SliderFlagsNone).
@@ -449,7 +533,11 @@ func SliderFloat3V(label string, values *[3]float32, min, max float32, format st
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 	cvalues := (*C.float)(&values[0])
-	return C.iggSliderFloatN(labelArg, cvalues, 3, C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	changed := C.iggSliderFloatN(labelArg, cvalues, 3, C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values[:]...)
+	}
+	return changed
 }
 
 // SliderFloat3 calls SliderFloat3V(label, values, min, max, "%.3f", SliderFlagsNone).
@@ -464,7 +552,11 @@ func SliderFloat4V(label string, values *[4]float32, min, max float32, format st
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 	cvalues := (*C.float)(&values[0])
-	return C.iggSliderFloatN(labelArg, cvalues, 4, C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	changed := C.iggSliderFloatN(labelArg, cvalues, 4, C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values[:]...)
+	}
+	return changed
 }
 
 // SliderFloat4 calls SliderFloat3V(label, values, min, max, "%.3f", SliderFlagsNone).
@@ -480,7 +572,11 @@ func SliderIntV(label string, value *int32, min, max int32, format string, flags
 	defer valueFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
-	return C.iggSliderInt(labelArg, valueArg, C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	changed := C.iggSliderInt(labelArg, valueArg, C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(int32(*valueArg))
+	}
+	return changed
 }
 
 // SliderInt calls SliderIntV(label, value, min, max, "%d", SliderFlagsNone).
@@ -489,47 +585,59 @@ func SliderInt(label string, value *int32, min, max int32) bool {
 }
 
 // SliderInt2V creates slider for a 2D vector.
-func SliderInt2V(label string, values *[2]int32, min, max int, format string, flags SliderFlags) bool {
+func SliderInt2V(label string, values *[2]int32, min, max int32, format string, flags SliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 	cvalues := (*C.int)(&values[0])
-	return C.iggSliderIntN(labelArg, cvalues, 2, C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	changed := C.iggSliderIntN(labelArg, cvalues, 2, C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values[:]...)
+	}
+	return changed
 }
 
 // SliderInt2 calls SliderInt2V(label, values, min, max, "%d", SliderFlagsNone).
-func SliderInt2(label string, values *[2]int32, min, max int) bool {
+func SliderInt2(label string, values *[2]int32, min, max int32) bool {
 	return SliderInt2V(label, values, min, max, "%d", SliderFlagsNone)
 }
 
 // SliderInt3V creates slider for a 3D vector.
-func SliderInt3V(label string, values *[3]int32, min, max int, format string, flags SliderFlags) bool {
+func SliderInt3V(label string, values *[3]int32, min, max int32, format string, flags SliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 	cvalues := (*C.int)(&values[0])
-	return C.iggSliderIntN(labelArg, cvalues, 3, C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	changed := C.iggSliderIntN(labelArg, cvalues, 3, C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values[:]...)
+	}
+	return changed
 }
 
 // SliderInt3 calls SliderInt3V(label, values, min, max, "%d", SliderFlagsNone).
-func SliderInt3(label string, values *[3]int32, min, max int) bool {
+func SliderInt3(label string, values *[3]int32, min, max int32) bool {
 	return SliderInt3V(label, values, min, max, "%d", SliderFlagsNone)
 }
 
 // SliderInt4V creates slider for a 4D vector.
-func SliderInt4V(label string, values *[4]int32, min, max int, format string, flags SliderFlags) bool {
+func SliderInt4V(label string, values *[4]int32, min, max int32, format string, flags SliderFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
 	cvalues := (*C.int)(&values[0])
-	return C.iggSliderIntN(labelArg, cvalues, 4, C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	changed := C.iggSliderIntN(labelArg, cvalues, 4, C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(values[:]...)
+	}
+	return changed
 }
 
 // SliderInt4 calls SliderInt4V(label, values, min, max, "%d", SliderFlagsNone).
-func SliderInt4(label string, values *[4]int32, min, max int) bool {
+func SliderInt4(label string, values *[4]int32, min, max int32) bool {
 	return SliderInt4V(label, values, min, max, "%d", SliderFlagsNone)
 }
 
@@ -542,7 +650,11 @@ func VSliderFloatV(label string, size Vec2, value *float32, min, max float32, fo
 	defer valueFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
-	return C.iggVSliderFloat(labelArg, sizeArg, valueArg, C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	changed := C.iggVSliderFloat(labelArg, sizeArg, valueArg, C.float(min), C.float(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(float32(*valueArg))
+	}
+	return changed
 }
 
 // VSliderFloat calls VSliderIntV(label, size, value, min, max, "%.3f", SliderFlagsNone).
@@ -559,7 +671,11 @@ func VSliderIntV(label string, size Vec2, value *int32, min, max int32, format s
 	defer valueFin()
 	formatArg, formatFin := wrapString(format)
 	defer formatFin()
-	return C.iggVSliderInt(labelArg, sizeArg, valueArg, C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	changed := C.iggVSliderInt(labelArg, sizeArg, valueArg, C.int(min), C.int(max), formatArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSlider, label); node != nil {
+		node.Value = accessibleValue(int32(*valueArg))
+	}
+	return changed
 }
 
 // VSliderInt calls VSliderIntV(label, size, value, min, max, "%d", SliderFlagsNone).
@@ -659,8 +775,12 @@ func inputTextSingleline(label string, hint *string, text *string, flags InputTe
 		state.release()
 	}()
 
-	return C.iggInputTextSingleline(labelArg, hintArg, (*C.char)(state.buf.ptr), C.uint(state.buf.size),
+	changed := C.iggInputTextSingleline(labelArg, hintArg, (*C.char)(state.buf.ptr), C.uint(state.buf.size),
 		C.int(flags|InputTextFlagsCallbackResize), state.key) != 0
+	if node := accessibleItem(AccessibilityRoleTextBox, label); (node != nil) && (flags&InputTextFlagsPassword == 0) {
+		node.Value = state.buf.toGo()
+	}
+	return changed
 }
 
 // InputTextMultilineV provides a field for dynamic text input of multiple lines.
@@ -684,8 +804,12 @@ func InputTextMultilineV(label string, text *string, size Vec2, flags InputTextF
 		state.release()
 	}()
 
-	return C.iggInputTextMultiline(labelArg, (*C.char)(state.buf.ptr), C.uint(state.buf.size), sizeArg,
+	changed := C.iggInputTextMultiline(labelArg, (*C.char)(state.buf.ptr), C.uint(state.buf.size), sizeArg,
 		C.int(flags|InputTextFlagsCallbackResize), state.key) != 0
+	if node := accessibleItem(AccessibilityRoleTextBox, label); (node != nil) && (flags&InputTextFlagsPassword == 0) {
+		node.Value = state.buf.toGo()
+	}
+	return changed
 }
 
 // InputTextMultiline calls InputTextMultilineV(label, text, Vec2{0,0}, 0, nil).
@@ -700,7 +824,11 @@ func InputIntV(label string, value *int32, step int, stepFast int, flags InputTe
 	valueArg, valueFin := wrapInt32(value)
 	defer valueFin()
 
-	return C.iggInputInt(labelArg, valueArg, C.int(step), C.int(stepFast), C.int(flags)) != 0
+	changed := C.iggInputInt(labelArg, valueArg, C.int(step), C.int(stepFast), C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleSpinButton, label); node != nil {
+		node.Value = accessibleValue(int32(*valueArg))
+	}
+	return changed
 }
 
 // InputInt calls InputIntV(label, value, 1, 100, 0).
@@ -717,7 +845,11 @@ func CollapsingHeader(label string) bool {
 func CollapsingHeaderV(label string, flags TreeNodeFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
-	return C.iggCollapsingHeader(labelArg, C.int(flags)) != 0
+	open := C.iggCollapsingHeader(labelArg, C.int(flags)) != 0
+	if node := accessibleItem(AccessibilityRoleTreeNode, label); node != nil {
+		node.Expanded = accessibleFlag(open)
+	}
+	return open
 }
 
 // TreeNodeFlags for TreeNodeV(), CollapsingHeaderV(), etc.
@@ -750,7 +882,11 @@ const (
 func TreeNodeV(label string, flags TreeNodeFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
-	return C.iggTreeNode(labelArg, C.int(flags)) != 0
+	open := C.iggTreeNode(labelArg, C.int(flags)) != 0
+	if node := accessibleContainer(AccessibilityRoleTreeNode, label, open && (flags&TreeNodeFlagsNoTreePushOnOpen == 0)); node != nil {
+		node.Expanded = accessibleFlag(open)
+	}
+	return open
 }
 
 // TreeNode calls TreeNodeV(label, 0).
@@ -765,6 +901,7 @@ func TreeNodef(format string, v ...interface{}) bool {
 
 // TreePop finishes a tree branch. This has to be called for a matching TreeNodeV call returning true.
 func TreePop() {
+	accessibleEnd(AccessibilityRoleTreeNode)
 	C.iggTreePop()
 }
 
@@ -799,7 +936,11 @@ func SelectableV(label string, selected bool, flags SelectableFlags, size Vec2)
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 	sizeArg, _ := size.wrapped()
-	return C.iggSelectable(labelArg, castBool(selected), C.int(flags), sizeArg) != 0
+	clicked := C.iggSelectable(labelArg, castBool(selected), C.int(flags), sizeArg) != 0
+	if node := accessibleItem(AccessibilityRoleSelectable, label); node != nil {
+		node.Selected = accessibleFlag(selected)
+	}
+	return clicked
 }
 
 // Selectable calls SelectableV(label, false, 0, Vec2{0, 0}).
@@ -817,7 +958,11 @@ func BeginListBoxV(label string, size Vec2) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
 	sizeArg, _ := size.wrapped()
-	return C.iggBeginListBox(labelArg, sizeArg) != 0
+	open := C.iggBeginListBox(labelArg, sizeArg) != 0
+	if open {
+		accessibleScope(AccessibilityRoleListBox, label, accessibilityBoundsFromItem)
+	}
+	return open
 }
 
 // BeginListBox calls BeginListBoxV(label, Vec2{}).
@@ -829,6 +974,7 @@ func BeginListBox(label string) bool {
 // only call EndListBox() if BeginListBox() returned true!
 func EndListBox() {
 	C.iggEndListBox()
+	accessibleEnd(AccessibilityRoleListBox)
 }
 
 // ListBoxV creates a list of selectables of given items with equal height, enclosed with header and footer.
@@ -856,7 +1002,11 @@ func ListBoxV(label string, currentItem *int32, items []string, heightItems int)
 		argv[i] = itemArg
 	}
 
-	return C.iggListBox(labelArg, valueArg, &argv[0], C.int(itemsCount), C.int(heightItems)) != 0
+	changed := C.iggListBox(labelArg, valueArg, &argv[0], C.int(itemsCount), C.int(heightItems)) != 0
+	if node := accessibleItem(AccessibilityRoleListBox, label); node != nil {
+		node.Value = accessibleItemText(items, *valueArg)
+	}
+	return changed
 }
 
 // ListBox calls ListBoxV(label, currentItem, items, -1)
@@ -896,6 +1046,7 @@ func PlotLinesV(label string, values []float32, valuesOffset int, overlayText st
 	graphSizeArg, _ := graphSize.wrapped()
 
 	C.iggPlotLines(labelArg, &valuesArray[0], C.int(valuesCount), C.int(valuesOffset), overlayTextArg, C.float(scaleMin), C.float(scaleMax), graphSizeArg)
+	accessibleItem(AccessibilityRolePlot, label)
 }
 
 // PlotHistogram draws an array of floats as a bar graph.
@@ -929,6 +1080,7 @@ func PlotHistogramV(label string, values []float32, valuesOffset int, overlayTex
 	graphSizeArg, _ := graphSize.wrapped()
 
 	C.iggPlotHistogram(labelArg, &valuesArray[0], C.int(valuesCount), C.int(valuesOffset), overlayTextArg, C.float(scaleMin), C.float(scaleMax), graphSizeArg)
+	accessibleItem(AccessibilityRolePlot, label)
 }
 
 // SetTooltip sets a text tooltip under the mouse-cursor, typically use with IsItemHovered().
@@ -948,22 +1100,29 @@ func SetTooltipf(format string, v ...interface{}) {
 // Requires a call to EndTooltip().
 func BeginTooltip() {
 	C.iggBeginTooltip()
+	accessibleWindow(AccessibilityRoleTooltip, "")
 }
 
 // EndTooltip closes the previously started tooltip window.
 func EndTooltip() {
+	accessibleEnd(AccessibilityRoleTooltip)
 	C.iggEndTooltip()
 }
 
 // BeginMainMenuBar creates and appends to a full screen menu-bar.
 // If the return value is true, then EndMainMenuBar() must be called!
 func BeginMainMenuBar() bool {
-	return C.iggBeginMainMenuBar() != 0
+	open := C.iggBeginMainMenuBar() != 0
+	if open {
+		accessibleWindow(AccessibilityRoleMenuBar, "")
+	}
+	return open
 }
 
 // EndMainMenuBar finishes a main menu bar.
 // Only call EndMainMenuBar() if BeginMainMenuBar() returns true!
 func EndMainMenuBar() {
+	accessibleEnd(AccessibilityRoleMenuBar)
 	C.iggEndMainMenuBar()
 }
 
@@ -971,12 +1130,17 @@ func EndMainMenuBar() {
 // This requires WindowFlagsMenuBar flag set on parent window.
 // If the return value is true, then EndMenuBar() must be called!
 func BeginMenuBar() bool {
-	return C.iggBeginMenuBar() != 0
+	open := C.iggBeginMenuBar() != 0
+	if open {
+		accessibleScope(AccessibilityRoleMenuBar, "", accessibilityBoundsFromChildren)
+	}
+	return open
 }
 
 // EndMenuBar finishes a menu bar.
 // Only call EndMenuBar() if BeginMenuBar() returns true!
 func EndMenuBar() {
+	accessibleEnd(AccessibilityRoleMenuBar)
 	C.iggEndMenuBar()
 }
 
@@ -985,7 +1149,11 @@ func EndMenuBar() {
 func BeginMenuV(label string, enabled bool) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
-	return C.iggBeginMenu(labelArg, castBool(enabled)) != 0
+	open := C.iggBeginMenu(labelArg, castBool(enabled)) != 0
+	if node := accessibleContainer(AccessibilityRoleMenu, label, open); node != nil {
+		node.Expanded = accessibleFlag(open)
+	}
+	return open
 }
 
 // BeginMenu calls BeginMenuV(label, true).
@@ -996,6 +1164,7 @@ func BeginMenu(label string) bool {
 // EndMenu finishes a sub-menu entry.
 // Only call EndMenu() if BeginMenu() returns true!
 func EndMenu() {
+	accessibleEnd(AccessibilityRoleMenu)
 	C.iggEndMenu()
 }
 
@@ -1008,7 +1177,13 @@ func MenuItemV(label string, shortcut string, selected bool, enabled bool) bool
 	defer labelFin()
 	shortcutArg, shortcutFin := wrapString(shortcut)
 	defer shortcutFin()
-	return C.iggMenuItem(labelArg, shortcutArg, castBool(selected), castBool(enabled)) != 0
+	clicked := C.iggMenuItem(labelArg, shortcutArg, castBool(selected), castBool(enabled)) != 0
+	if node := accessibleItem(AccessibilityRoleMenuItem, label); node != nil {
+		if selected {
+			node.Checked = accessibleFlag(true)
+		}
+	}
+	return clicked
 }
 
 // MenuItem calls MenuItemV(label, "", false, true).
@@ -1101,7 +1276,11 @@ func BeginTabBarV(strID string, flags TabBarFlags) bool {
 	idArg, idFin := wrapString(strID)
 	defer idFin()
 
-	return C.iggBeginTabBar(idArg, C.int(flags)) != 0
+	open := C.iggBeginTabBar(idArg, C.int(flags)) != 0
+	if open {
+		accessibleScope(AccessibilityRoleTabBar, strID, accessibilityBoundsFromChildren)
+	}
+	return open
 }
 
 // BeginTabBar calls BeginTabBarV(strId, 0).
@@ -1111,6 +1290,7 @@ func BeginTabBar(strID string) bool {
 
 // EndTabBar only call EndTabBar() if BeginTabBar() returns true!
 func EndTabBar() {
+	accessibleEnd(AccessibilityRoleTabBar)
 	C.iggEndTabBar()
 }
 
@@ -1138,7 +1318,11 @@ func BeginTabItemV(label string, open *bool, flags TabItemFlags) bool {
 	openArg, openFin := wrapBool(open)
 	defer openFin()
 
-	return C.iggBeginTabItem(labelArg, openArg, C.int(flags)) != 0
+	selected := C.iggBeginTabItem(labelArg, openArg, C.int(flags)) != 0
+	if node := accessibleContainer(AccessibilityRoleTab, label, selected); node != nil {
+		node.Selected = accessibleFlag(selected)
+	}
+	return selected
 }
 
 // BeginTabItem calls BeginTabItemV(label, nil, 0).
@@ -1149,6 +1333,7 @@ func BeginTabItem(label string) bool {
 // EndTabItem finishes a tab item.
 // Don't call PushID(tab->ID)/PopID() on BeginTabItem()/EndTabItem().
 func EndTabItem() {
+	accessibleEnd(AccessibilityRoleTab)
 	C.iggEndTabItem()
 }
 
@@ -1156,7 +1341,9 @@ func EndTabItem() {
 func TabItemButtonV(label string, flags TabItemFlags) bool {
 	labelArg, labelFin := wrapString(label)
 	defer labelFin()
-	return C.iggTabItemButton(labelArg, C.int(flags)) != 0
+	clicked := C.iggTabItemButton(labelArg, C.int(flags)) != 0
+	accessibleItem(AccessibilityRoleTab, label)
+	return clicked
 }
 
 // TabItemButton calls TabItemButtonV(label, 0).
@@ -56,7 +56,9 @@ func BeginV(id string, open *bool, flags WindowFlags) bool {
 	defer idFin()
 	openArg, openFin := wrapBool(open)
 	defer openFin()
-	return C.iggBegin(idArg, openArg, C.int(flags)) != 0
+	visible := C.iggBegin(idArg, openArg, C.int(flags)) != 0
+	accessibleWindow(AccessibilityRoleWindow, id)
+	return visible
 }
 
 // Begin calls BeginV(id, nil, 0).
@@ -67,6 +69,7 @@ func Begin(id string) bool {
 // End closes the scope for the previously opened window.
 // Every call to Begin() must be matched with a call to End().
 func End() {
+	accessibleEnd(AccessibilityRoleWindow)
 	C.iggEnd()
 }
 
@@ -92,7 +95,9 @@ func BeginChildV(id string, size Vec2, border bool, flags ChildFlags) bool {
 	idArg, idFin := wrapString(id)
 	defer idFin()
 	sizeArg, _ := size.wrapped()
-	return C.iggBeginChild(idArg, sizeArg, castBool(border), C.int(flags)) != 0
+	visible := C.iggBeginChild(idArg, sizeArg, castBool(border), C.int(flags)) != 0
+	accessibleWindow(AccessibilityRoleChildWindow, id)
+	return visible
 }
 
 // BeginChild calls BeginChildV(id, Vec2{0,0}, false, 0).
@@ -104,6 +109,7 @@ func BeginChild(id string) bool {
 // Every call to BeginChild() must be matched with a call to EndChild().
 func EndChild() {
 	C.iggEndChild()
+	accessibleEnd(AccessibilityRoleChildWindow)
 }
 
 // WindowPos returns the current window position in screen space.
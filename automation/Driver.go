@@ -0,0 +1,375 @@
+// Package automation drives the UI of an imgui.Context from Go code, without a display.
+//
+// A Driver runs the frames of a context and acts like a user: it finds items by a path
+// of window name and labels, moves the virtual mouse onto them, clicks, drags, presses keys
+// and types text. All input is injected through the input event queue of imgui.IO.
+//
+//	func TestSaveAsksForConfirmation(t *testing.T) {
+//		context := imgui.CreateContext(nil)
+//		defer context.Destroy()
+//		imgui.CurrentIO().SetIniFilename("")
+//		imgui.CurrentIO().Fonts().TextureDataRGBA32()
+//
+//		app := newApp()
+//		driver := automation.NewDriver(context, app.Render)
+//		require.NoError(t, driver.Click("Settings/Save"))
+//		require.NoError(t, driver.WaitUntil(func() bool { return app.confirmationOpen }))
+//	}
+//
+// Items are located with the item registry of the context, which the driver enables.
+// An item is found if it was submitted in the last frame. Functions that locate items run further
+// frames until the item appears, up to MaxWaitFrames.
+//
+// # Item references
+//
+// A reference is a path of labels, separated by "/". The first element is the name of the window,
+// and the following elements are the labels (or IDs) of the items, as pushed onto the ID stack.
+// For example, "Settings/Audio/Volume" refers to the item "Volume" within the tree node "Audio"
+// of the window "Settings". A "/" within a label is written as "\/".
+//
+// Popups opened with OpenPopup() and BeginPopup() are windows with a generated name, see PopupRef().
+// Modal popups are windows named after their label.
+package automation
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// ErrItemNotFound is returned if an item does not appear within MaxWaitFrames frames.
+var ErrItemNotFound = errors.New("item not found")
+
+// ErrItemClipped is returned if an item exists, but is not visible within its window.
+var ErrItemClipped = errors.New("item is clipped")
+
+// ErrTimeout is returned by WaitUntil() if the condition is not met within MaxWaitFrames frames.
+var ErrTimeout = errors.New("timeout waiting for condition")
+
+// Driver runs the frames of a context and injects user input.
+type Driver struct {
+	context *imgui.Context
+	gui     func()
+
+	// DisplaySize is set as the display size before every frame. Default: 800x600.
+	DisplaySize imgui.Vec2
+	// DeltaTime is set as the delta time before every frame, in seconds. Default: 1/60.
+	DeltaTime float32
+	// MaxWaitFrames is the number of frames to wait for items and conditions. Default: 60.
+	MaxWaitFrames int
+	// DragSteps is the number of frames a drag moves the mouse over. Default: 4.
+	DragSteps int
+
+	frames   int
+	mousePos imgui.Vec2
+}
+
+// NewDriver returns a driver for the given context. gui is called once per frame,
+// between imgui.NewFrame() and imgui.Render(), and submits the windows of the application.
+//
+// The driver makes the context current, and enables its item registry.
+func NewDriver(context *imgui.Context, gui func()) *Driver {
+	driver := &Driver{
+		context:       context,
+		gui:           gui,
+		DisplaySize:   imgui.Vec2{X: 800, Y: 600},
+		DeltaTime:     1.0 / 60.0,
+		MaxWaitFrames: 60,
+		DragSteps:     4,
+	}
+	_ = context.SetCurrent()
+	imgui.SetItemRegistryEnabled(true)
+	return driver
+}
+
+// Context returns the context the driver runs.
+func (driver *Driver) Context() *imgui.Context {
+	return driver.context
+}
+
+// Frames returns the number of frames the driver has run.
+func (driver *Driver) Frames() int {
+	return driver.frames
+}
+
+// MousePos returns the position of the virtual mouse.
+func (driver *Driver) MousePos() imgui.Vec2 {
+	return driver.mousePos
+}
+
+// Frame runs one frame of the application.
+func (driver *Driver) Frame() error {
+	io, err := driver.io()
+	if err != nil {
+		return err
+	}
+	io.SetDisplaySize(driver.DisplaySize)
+	io.SetDeltaTime(driver.DeltaTime)
+	imgui.NewFrame()
+	driver.gui()
+	imgui.Render()
+	driver.frames++
+	return nil
+}
+
+// RunFrames runs the given number of frames.
+func (driver *Driver) RunFrames(count int) error {
+	for i := 0; i < count; i++ {
+		if err := driver.Frame(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WaitUntil runs frames until the condition is true, up to MaxWaitFrames.
+// The condition is checked before every frame.
+func (driver *Driver) WaitUntil(condition func() bool) error {
+	for i := 0; i < driver.MaxWaitFrames; i++ {
+		if condition() {
+			return nil
+		}
+		if err := driver.Frame(); err != nil {
+			return err
+		}
+	}
+	if condition() {
+		return nil
+	}
+	return ErrTimeout
+}
+
+// ItemID returns the ID of the item with the given reference.
+func (driver *Driver) ItemID(ref string) imgui.ID {
+	_ = driver.context.SetCurrent()
+	var id imgui.ID
+	for _, label := range splitRef(ref) {
+		id = imgui.GetIDWithSeed(label, id)
+	}
+	return id
+}
+
+// Find returns the item with given reference. If the item was not submitted in the last frame,
+// it runs frames until the item appears, up to MaxWaitFrames.
+func (driver *Driver) Find(ref string) (imgui.RegisteredItem, error) {
+	id := driver.ItemID(ref)
+	for i := 0; ; i++ {
+		if driver.frames > 0 {
+			if item, found := imgui.LookupRegisteredItem(id); found {
+				return item, nil
+			}
+		}
+		if i >= driver.MaxWaitFrames {
+			return imgui.RegisteredItem{}, fmt.Errorf("%w: %q", ErrItemNotFound, ref)
+		}
+		if err := driver.Frame(); err != nil {
+			return imgui.RegisteredItem{}, err
+		}
+	}
+}
+
+// Exists returns true if the item with given reference was submitted in the last frame.
+// Unlike Find(), it does not run any frames.
+func (driver *Driver) Exists(ref string) bool {
+	_, found := imgui.LookupRegisteredItem(driver.ItemID(ref))
+	return found
+}
+
+// MouseMoveToPos moves the virtual mouse to the given position, and runs a frame.
+func (driver *Driver) MouseMoveToPos(pos imgui.Vec2) error {
+	io, err := driver.io()
+	if err != nil {
+		return err
+	}
+	driver.mousePos = pos
+	io.AddMousePosEvent(pos)
+	return driver.Frame()
+}
+
+// MouseMove moves the virtual mouse to the center of the visible part of the item, and runs a frame.
+func (driver *Driver) MouseMove(ref string) error {
+	pos, err := driver.itemPos(ref)
+	if err != nil {
+		return err
+	}
+	return driver.MouseMoveToPos(pos)
+}
+
+// MouseDown presses the given mouse button at the current position, and runs a frame.
+func (driver *Driver) MouseDown(button int) error {
+	io, err := driver.io()
+	if err != nil {
+		return err
+	}
+	io.AddMouseButtonEvent(button, true)
+	return driver.Frame()
+}
+
+// MouseUp releases the given mouse button at the current position, and runs a frame.
+func (driver *Driver) MouseUp(button int) error {
+	io, err := driver.io()
+	if err != nil {
+		return err
+	}
+	io.AddMouseButtonEvent(button, false)
+	return driver.Frame()
+}
+
+// MouseClick presses and releases the given mouse button at the current position.
+func (driver *Driver) MouseClick(button int) error {
+	if err := driver.MouseDown(button); err != nil {
+		return err
+	}
+	return driver.MouseUp(button)
+}
+
+// Click moves the mouse onto the item and clicks it with the left mouse button.
+func (driver *Driver) Click(ref string) error {
+	return driver.ClickButton(ref, 0)
+}
+
+// ClickButton moves the mouse onto the item and clicks it with the given mouse button.
+func (driver *Driver) ClickButton(ref string, button int) error {
+	if err := driver.MouseMove(ref); err != nil {
+		return err
+	}
+	return driver.MouseClick(button)
+}
+
+// DoubleClick moves the mouse onto the item and double-clicks it with the left mouse button.
+func (driver *Driver) DoubleClick(ref string) error {
+	if err := driver.MouseMove(ref); err != nil {
+		return err
+	}
+	if err := driver.MouseClick(0); err != nil {
+		return err
+	}
+	return driver.MouseClick(0)
+}
+
+// Drag moves the mouse onto the item, and drags it by the given delta with the left mouse button.
+func (driver *Driver) Drag(ref string, delta imgui.Vec2) error {
+	if err := driver.MouseMove(ref); err != nil {
+		return err
+	}
+	return driver.DragToPos(driver.mousePos.Plus(delta))
+}
+
+// DragTo moves the mouse onto the item, and drags it onto the target item with the left mouse button.
+func (driver *Driver) DragTo(ref string, targetRef string) error {
+	target, err := driver.itemPos(targetRef)
+	if err != nil {
+		return err
+	}
+	if err := driver.MouseMove(ref); err != nil {
+		return err
+	}
+	return driver.DragToPos(target)
+}
+
+// DragToPos drags from the current position to the target position with the left mouse button.
+// The mouse moves over DragSteps frames.
+func (driver *Driver) DragToPos(target imgui.Vec2) error {
+	if err := driver.MouseDown(0); err != nil {
+		return err
+	}
+	start := driver.mousePos
+	steps := max(driver.DragSteps, 1)
+	for step := 1; step <= steps; step++ {
+		ratio := float32(step) / float32(steps)
+		pos := start.Plus(target.Minus(start).Times(ratio))
+		if err := driver.MouseMoveToPos(pos); err != nil {
+			return err
+		}
+	}
+	return driver.MouseUp(0)
+}
+
+// KeyDown presses the key, and runs a frame. Modifiers, such as imgui.KeyModCtrl, are supported.
+func (driver *Driver) KeyDown(key imgui.ImguiKey) error {
+	io, err := driver.io()
+	if err != nil {
+		return err
+	}
+	io.AddKeyEvent(key, true)
+	return driver.Frame()
+}
+
+// KeyUp releases the key, and runs a frame.
+func (driver *Driver) KeyUp(key imgui.ImguiKey) error {
+	io, err := driver.io()
+	if err != nil {
+		return err
+	}
+	io.AddKeyEvent(key, false)
+	return driver.Frame()
+}
+
+// KeyPress presses and releases the key chord, such as imgui.NewKeyChord(imgui.KeyS, imgui.KeyModCtrl).
+// The modifiers are pressed first, and released last.
+func (driver *Driver) KeyPress(chord imgui.KeyChord) error {
+	io, err := driver.io()
+	if err != nil {
+		return err
+	}
+	mods := chordMods(chord)
+	for _, mod := range mods {
+		io.AddKeyEvent(mod, true)
+	}
+	if err := driver.KeyDown(chord.Key()); err != nil {
+		return err
+	}
+	io.AddKeyEvent(chord.Key(), false)
+	for _, mod := range mods {
+		io.AddKeyEvent(mod, false)
+	}
+	return driver.Frame()
+}
+
+// Type enters the text into the active item, such as an input field, and runs a frame.
+func (driver *Driver) Type(text string) error {
+	io, err := driver.io()
+	if err != nil {
+		return err
+	}
+	io.AddInputCharacters(text)
+	return driver.Frame()
+}
+
+// io makes the context of the driver current, and returns its IO. Events are queued into this IO,
+// even if the application makes another context current in the meantime.
+func (driver *Driver) io() (imgui.IO, error) {
+	if err := driver.context.SetCurrent(); err != nil {
+		return imgui.IO{}, err
+	}
+	return imgui.CurrentIO(), nil
+}
+
+// PopupRef returns the reference of the window of a popup with given ID. The ID is the one of the
+// label of OpenPopup(), in the ID stack the popup was opened in, such as driver.ItemID("Settings/Options").
+func PopupRef(popupID imgui.ID) string {
+	return fmt.Sprintf("##Popup_%08x", uint32(popupID))
+}
+
+func (driver *Driver) itemPos(ref string) (imgui.Vec2, error) {
+	item, err := driver.Find(ref)
+	if err != nil {
+		return imgui.Vec2{}, err
+	}
+	min, max := item.VisibleRect()
+	if (min.X >= max.X) || (min.Y >= max.Y) {
+		return imgui.Vec2{}, fmt.Errorf("%w: %q", ErrItemClipped, ref)
+	}
+	return min.Plus(max).Times(0.5), nil
+}
+
+func chordMods(chord imgui.KeyChord) []imgui.ImguiKey {
+	var mods []imgui.ImguiKey
+	for _, mod := range []imgui.ImguiKey{imgui.KeyModCtrl, imgui.KeyModShift, imgui.KeyModAlt, imgui.KeyModSuper} {
+		if chord.Mods()&mod != 0 {
+			mods = append(mods, mod)
+		}
+	}
+	return mods
+}
@@ -0,0 +1,142 @@
+package automation_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+	"github.com/jetsetilly/imgui-go/v5/automation"
+)
+
+type settingsApp struct {
+	saves       int
+	confirmOpen bool
+	enabled     bool
+	name        string
+	volume      int32
+	lastKey     bool
+}
+
+func (app *settingsApp) render() {
+	imgui.SetNextWindowPos(imgui.Vec2{X: 10, Y: 10})
+	imgui.SetNextWindowSize(imgui.Vec2{X: 300, Y: 200})
+	imgui.Begin("Settings")
+	if imgui.Button("Save") {
+		imgui.OpenPopup("Confirm")
+	}
+	imgui.Checkbox("Enabled", &app.enabled)
+	imgui.InputText("Name", &app.name)
+	imgui.DragIntV("Volume", &app.volume, 1, 0, 100, "%d", imgui.SliderFlagsNone)
+	if imgui.Shortcut(imgui.NewKeyChord(imgui.KeyS, imgui.KeyModCtrl)) {
+		app.lastKey = true
+	}
+
+	app.confirmOpen = imgui.BeginPopupModal("Confirm")
+	if app.confirmOpen {
+		if imgui.Button("Yes/No?") {
+			app.saves++
+			imgui.CloseCurrentPopup()
+		}
+		imgui.EndPopup()
+	}
+	imgui.End()
+}
+
+func newDriver(t *testing.T, app *settingsApp) *automation.Driver {
+	context := imgui.CreateContext(nil)
+	t.Cleanup(context.Destroy)
+	io := imgui.CurrentIO()
+	io.SetIniFilename("")
+	io.Fonts().TextureDataRGBA32()
+	return automation.NewDriver(context, app.render)
+}
+
+func TestClickOpensAndClosesPopup(t *testing.T) {
+	app := &settingsApp{}
+	driver := newDriver(t, app)
+
+	require.NoError(t, driver.Click("Settings/Save"))
+	require.NoError(t, driver.WaitUntil(func() bool { return app.confirmOpen }))
+	require.NoError(t, driver.Click(`Confirm/Yes\/No?`))
+	require.NoError(t, driver.WaitUntil(func() bool { return !app.confirmOpen }))
+	assert.Equal(t, 1, app.saves)
+}
+
+func TestWidgetsReceiveInput(t *testing.T) {
+	app := &settingsApp{}
+	driver := newDriver(t, app)
+
+	require.NoError(t, driver.Click("Settings/Enabled"))
+	assert.True(t, app.enabled, "checkbox should be toggled")
+
+	require.NoError(t, driver.Click("Settings/Name"))
+	require.NoError(t, driver.Type("Gopher"))
+	require.NoError(t, driver.KeyPress(imgui.NewKeyChord(imgui.KeyBackspace)))
+	require.NoError(t, driver.KeyPress(imgui.NewKeyChord(imgui.KeyEnter)))
+	assert.Equal(t, "Gophe", app.name)
+
+	require.NoError(t, driver.Drag("Settings/Volume", imgui.Vec2{X: 40}))
+	assert.True(t, app.volume > 10, "volume should be dragged, is %d", app.volume)
+	dragged := app.volume
+	// wait out the double-click time, which would turn the widget into a text input
+	require.NoError(t, driver.RunFrames(30))
+	require.NoError(t, driver.MouseMove("Settings/Volume"))
+	require.NoError(t, driver.DragToPos(driver.MousePos().Minus(imgui.Vec2{X: 20})))
+	assert.True(t, app.volume < dragged, "volume should be dragged back, is %d", app.volume)
+
+	require.NoError(t, driver.KeyPress(imgui.NewKeyChord(imgui.KeyS, imgui.KeyModCtrl)))
+	assert.True(t, app.lastKey, "shortcut should be pressed")
+}
+
+func TestFindReportsMissingItems(t *testing.T) {
+	app := &settingsApp{}
+	driver := newDriver(t, app)
+	driver.MaxWaitFrames = 3
+
+	item, err := driver.Find("Settings/Save")
+	require.NoError(t, err)
+	assert.Equal(t, driver.ItemID("Settings/Save"), item.ID)
+	assert.Equal(t, driver.ItemID("Settings"), item.WindowID)
+	assert.True(t, driver.Exists("Settings"), "window should be registered")
+
+	_, err = driver.Find("Settings/Load")
+	assert.True(t, errors.Is(err, automation.ErrItemNotFound), "unexpected error %v", err)
+	assert.True(t, errors.Is(driver.WaitUntil(func() bool { return false }), automation.ErrTimeout))
+}
+
+func TestItemIDFollowsTheIDStack(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	t.Cleanup(context.Destroy)
+	imgui.CurrentIO().SetIniFilename("")
+	imgui.CurrentIO().Fonts().TextureDataRGBA32()
+
+	var saveID imgui.ID
+	driver := automation.NewDriver(context, func() {
+		imgui.Begin("Settings")
+		saveID = imgui.GetID("Save")
+		imgui.End()
+	})
+	require.NoError(t, driver.Frame())
+	assert.Equal(t, saveID, driver.ItemID("Settings/Save"))
+	assert.Equal(t, saveID, driver.ItemID("/Settings/Save"))
+	assert.NotEqual(t, saveID, driver.ItemID(`Settings\/Save`))
+}
+
+func TestInputIsQueuedIntoTheContextOfTheDriver(t *testing.T) {
+	app := &settingsApp{}
+	driver := newDriver(t, app)
+	other := imgui.CreateContext(nil)
+	t.Cleanup(other.Destroy)
+
+	item, err := driver.Find("Settings/Enabled")
+	require.NoError(t, err)
+	min, max := item.VisibleRect()
+	require.NoError(t, other.SetCurrent())
+	require.NoError(t, driver.MouseMoveToPos(min.Plus(max).Times(0.5)))
+	require.NoError(t, other.SetCurrent())
+	require.NoError(t, driver.MouseClick(0))
+	assert.True(t, app.enabled, "checkbox should be toggled")
+}
@@ -0,0 +1,23 @@
+package automation
+
+import "strings"
+
+// splitRef splits a reference into its labels. A leading "/" is ignored, and "\/" is a "/" within a label.
+func splitRef(ref string) []string {
+	ref = strings.TrimPrefix(ref, "/")
+	var labels []string
+	var label strings.Builder
+	for i := 0; i < len(ref); i++ {
+		switch {
+		case (ref[i] == '\\') && (i+1 < len(ref)) && (ref[i+1] == '/'):
+			label.WriteByte('/')
+			i++
+		case ref[i] == '/':
+			labels = append(labels, label.String())
+			label.Reset()
+		default:
+			label.WriteByte(ref[i])
+		}
+	}
+	return append(labels, label.String())
+}
@@ -0,0 +1,239 @@
+// Package golden provides golden-image snapshot testing of ImGui frames.
+//
+// A snapshot creates its own Context, runs a fixed number of frames with a given display size,
+// renders the last frame with the software renderer and compares the result against a stored PNG:
+//
+//	func TestSettingsWindow(t *testing.T) {
+//		golden.Check(t, "settings", golden.Options{DisplaySize: imgui.Vec2{X: 400, Y: 300}}, func() {
+//			imgui.Begin("Settings")
+//			imgui.Checkbox("Enabled", &enabled)
+//			imgui.End()
+//		})
+//	}
+//
+// Golden files are (re-)written instead of compared if Options.Update is set, or if the
+// environment variable IMGUI_GOLDEN_UPDATE is set to a non-empty value.
+package golden
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jetsetilly/imgui-go/v5"
+	"github.com/jetsetilly/imgui-go/v5/softrender"
+)
+
+// UpdateEnv is the name of the environment variable that enables the update mode.
+const UpdateEnv = "IMGUI_GOLDEN_UPDATE"
+
+// Options describe how a snapshot is created and compared.
+// The zero value is usable and selects the defaults as described per field.
+type Options struct {
+	// DisplaySize is the size of the display. Default: 800x600.
+	DisplaySize imgui.Vec2
+	// Frames is the number of NewFrame()/Render() cycles. Only the last frame is compared.
+	// Windows require at least two frames to calculate their automatic size. Default: 2.
+	Frames int
+	// DeltaTime is the time set for each frame, in seconds. Default: 1/60.
+	DeltaTime float32
+	// ClearColor is the background of the rendered image. Default: opaque black.
+	ClearColor color.Color
+	// Tolerance is the maximum difference per color channel for two pixels to be considered equal.
+	Tolerance uint8
+	// Update writes the golden file instead of comparing against it.
+	Update bool
+}
+
+func (opts Options) withDefaults() Options {
+	if (opts.DisplaySize.X <= 0) || (opts.DisplaySize.Y <= 0) {
+		opts.DisplaySize = imgui.Vec2{X: 800, Y: 600}
+	}
+	if opts.Frames <= 0 {
+		opts.Frames = 2
+	}
+	if opts.DeltaTime <= 0 {
+		opts.DeltaTime = 1.0 / 60.0
+	}
+	if opts.ClearColor == nil {
+		opts.ClearColor = color.Black
+	}
+	if os.Getenv(UpdateEnv) != "" {
+		opts.Update = true
+	}
+	return opts
+}
+
+// Render creates a new context, runs the configured number of frames and returns the image of the last frame.
+// The frame function is called between NewFrame() and Render() of every frame.
+// The previously current context, if any, is current again when Render returns.
+func Render(opts Options, frame func()) *image.RGBA {
+	opts = opts.withDefaults()
+
+	previous, _ := imgui.CurrentContext()
+	context := imgui.CreateContext(nil)
+	_ = context.SetCurrent()
+	defer func() {
+		context.Destroy()
+		if previous != nil {
+			_ = previous.SetCurrent()
+		}
+	}()
+
+	io := imgui.CurrentIO()
+	io.SetIniFilename("")
+	io.SetDisplaySize(opts.DisplaySize)
+	io.SetDeltaTime(opts.DeltaTime)
+
+	renderer := softrender.NewRenderer()
+	renderer.SetClearColor(opts.ClearColor)
+	renderer.RegisterFontAtlas(io.Fonts())
+
+	for i := 0; i < opts.Frames; i++ {
+		imgui.NewFrame()
+		frame()
+		imgui.Render()
+	}
+	return renderer.Render(imgui.RenderedDrawData())
+}
+
+// Compare compares two images pixel by pixel. Two pixels are equal if none of their
+// color channels differ by more than the tolerance.
+//
+// It returns the number of mismatching pixels and an image that highlights them in red,
+// on top of a faded copy of the wanted image.
+// Images of different size are reported as mismatching in every pixel, without a diff image.
+func Compare(got, want image.Image, tolerance uint8) (mismatches int, diff *image.RGBA) {
+	gotBounds := got.Bounds()
+	wantBounds := want.Bounds()
+	if gotBounds.Size() != wantBounds.Size() {
+		mismatches = gotBounds.Dx() * gotBounds.Dy()
+		if wantArea := wantBounds.Dx() * wantBounds.Dy(); wantArea > mismatches {
+			mismatches = wantArea
+		}
+		return mismatches, nil
+	}
+
+	diff = image.NewRGBA(image.Rect(0, 0, wantBounds.Dx(), wantBounds.Dy()))
+	for y := 0; y < wantBounds.Dy(); y++ {
+		for x := 0; x < wantBounds.Dx(); x++ {
+			gotColor := color.NRGBAModel.Convert(got.At(gotBounds.Min.X+x, gotBounds.Min.Y+y)).(color.NRGBA)
+			wantColor := color.NRGBAModel.Convert(want.At(wantBounds.Min.X+x, wantBounds.Min.Y+y)).(color.NRGBA)
+			if channelsWithin(gotColor, wantColor, tolerance) {
+				gray := uint8((uint16(wantColor.R) + uint16(wantColor.G) + uint16(wantColor.B)) / 3 / 4)
+				diff.SetRGBA(x, y, color.RGBA{R: gray, G: gray, B: gray, A: 0xFF})
+			} else {
+				mismatches++
+				diff.SetRGBA(x, y, color.RGBA{R: 0xFF, A: 0xFF})
+			}
+		}
+	}
+	return mismatches, diff
+}
+
+func channelsWithin(a, b color.NRGBA, tolerance uint8) bool {
+	within := func(x, y uint8) bool {
+		if x > y {
+			return x-y <= tolerance
+		}
+		return y-x <= tolerance
+	}
+	return within(a.R, b.R) && within(a.G, b.G) && within(a.B, b.B) && within(a.A, b.A)
+}
+
+// MismatchError is returned by Verify if the rendered frame does not match the golden file.
+type MismatchError struct {
+	// Path is the golden file.
+	Path string
+	// Mismatches is the number of pixels outside of the tolerance.
+	Mismatches int
+	// DiffPath is the file of the diff image, or empty if the image sizes differ.
+	DiffPath string
+	// ActualPath is the file the rendered frame was written to.
+	ActualPath string
+}
+
+// Error returns the string representation.
+func (err MismatchError) Error() string {
+	msg := fmt.Sprintf("%s: %d pixels differ, actual image written to %s", err.Path, err.Mismatches, err.ActualPath)
+	if err.DiffPath != "" {
+		msg += ", diff image written to " + err.DiffPath
+	}
+	return msg
+}
+
+// Verify renders the frames and compares the result against the golden PNG file at path.
+//
+// On a mismatch, the rendered image is written next to the golden file with the suffix ".actual.png",
+// and the diff image with the suffix ".diff.png". A MismatchError is returned in this case.
+// In update mode, the golden file (and its directory) is created or replaced instead.
+func Verify(path string, opts Options, frame func()) error {
+	opts = opts.withDefaults()
+	got := Render(opts, frame)
+
+	if opts.Update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return err
+		}
+		return writePNG(path, got)
+	}
+
+	want, err := readPNG(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("%w (set %s=1 to create it)", err, UpdateEnv)
+	}
+	if err != nil {
+		return err
+	}
+
+	mismatches, diff := Compare(got, want, opts.Tolerance)
+	if mismatches == 0 {
+		return nil
+	}
+	base := path[:len(path)-len(filepath.Ext(path))]
+	result := MismatchError{Path: path, Mismatches: mismatches, ActualPath: base + ".actual.png"}
+	if err := writePNG(result.ActualPath, got); err != nil {
+		return err
+	}
+	if diff != nil {
+		result.DiffPath = base + ".diff.png"
+		if err := writePNG(result.DiffPath, diff); err != nil {
+			return err
+		}
+	}
+	return result
+}
+
+// Check calls Verify for the golden file "testdata/<name>.png" and reports an error through t.
+func Check(t testing.TB, name string, opts Options, frame func()) {
+	t.Helper()
+	if err := Verify(filepath.Join("testdata", name+".png"), opts, frame); err != nil {
+		t.Error(err)
+	}
+}
+
+func readPNG(path string) (image.Image, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return png.Decode(file)
+}
+
+func writePNG(path string, img image.Image) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := png.Encode(file, img); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
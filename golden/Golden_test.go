@@ -0,0 +1,77 @@
+package golden_test
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+	"github.com/jetsetilly/imgui-go/v5/golden"
+)
+
+func window(label string) func() {
+	return func() {
+		imgui.SetNextWindowPos(imgui.Vec2{X: 10, Y: 10})
+		imgui.SetNextWindowSize(imgui.Vec2{X: 150, Y: 80})
+		imgui.Begin("Golden")
+		imgui.Button(label)
+		imgui.End()
+	}
+}
+
+func TestVerifyAfterUpdateSucceeds(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "window.png")
+	opts := golden.Options{DisplaySize: imgui.Vec2{X: 200, Y: 120}}
+
+	updateOpts := opts
+	updateOpts.Update = true
+	require.NoError(t, golden.Verify(path, updateOpts, window("OK")))
+
+	assert.NoError(t, golden.Verify(path, opts, window("OK")))
+}
+
+func TestVerifyReportsMismatchWithDiffImage(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "window.png")
+	opts := golden.Options{DisplaySize: imgui.Vec2{X: 200, Y: 120}}
+
+	updateOpts := opts
+	updateOpts.Update = true
+	require.NoError(t, golden.Verify(path, updateOpts, window("OK")))
+
+	err := golden.Verify(path, opts, window("Cancel"))
+	var mismatch golden.MismatchError
+	require.True(t, errors.As(err, &mismatch), "mismatch expected, got %v", err)
+	assert.True(t, mismatch.Mismatches > 0, "mismatching pixels expected")
+	assert.FileExists(t, mismatch.DiffPath)
+	assert.FileExists(t, mismatch.ActualPath)
+}
+
+func TestVerifyReportsMissingGolden(t *testing.T) {
+	err := golden.Verify(filepath.Join(t.TempDir(), "missing.png"), golden.Options{}, func() {})
+	assert.True(t, errors.Is(err, os.ErrNotExist), "missing file error expected, got %v", err)
+}
+
+func TestCompareHonoursTolerance(t *testing.T) {
+	want := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	got := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	want.SetRGBA(0, 0, color.RGBA{R: 100, A: 0xFF})
+	got.SetRGBA(0, 0, color.RGBA{R: 102, A: 0xFF})
+
+	mismatches, _ := golden.Compare(got, want, 2)
+	assert.Equal(t, 0, mismatches)
+	mismatches, diff := golden.Compare(got, want, 1)
+	assert.Equal(t, 1, mismatches)
+	assert.Equal(t, color.RGBA{R: 0xFF, A: 0xFF}, diff.RGBAAt(0, 0))
+}
+
+func TestCompareReportsSizeMismatch(t *testing.T) {
+	mismatches, diff := golden.Compare(image.NewRGBA(image.Rect(0, 0, 2, 2)), image.NewRGBA(image.Rect(0, 0, 3, 1)), 0)
+	assert.Equal(t, 4, mismatches)
+	assert.Nil(t, diff)
+}
@@ -0,0 +1,33 @@
+package record
+
+import "errors"
+
+// Magic is the signature at the start of every recording.
+const Magic = "IGRC"
+
+// Version is the version of the file format written by the Recorder.
+const Version = 1
+
+// ErrInvalidRecording is returned by the Player if the data is not a recording, or is corrupt.
+var ErrInvalidRecording = errors.New("invalid recording")
+
+// ErrUnsupportedVersion is returned by NewPlayer() if the recording was written with a newer format version.
+var ErrUnsupportedVersion = errors.New("unsupported recording version")
+
+type opcode byte
+
+const (
+	opFrame opcode = iota + 1
+	opDisplaySize
+	opKey
+	opKeyAnalog
+	opMousePos
+	opMouseButton
+	opMouseWheel
+	opMouseSource
+	opFocus
+	opCharacters
+	opCharacterUTF16
+	opClearInputKeys
+	opClearInputMouse
+)
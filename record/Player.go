@@ -0,0 +1,226 @@
+package record
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// Player feeds a recording into an imgui.IO.
+type Player struct {
+	io imgui.IO
+	r  *bufio.Reader
+
+	frame int
+}
+
+// NewPlayer returns a player for the recording in r. It reads and checks the header of the recording.
+func NewPlayer(target imgui.IO, r io.Reader) (*Player, error) {
+	player := &Player{
+		io: target,
+		r:  bufio.NewReader(r),
+	}
+	var header [len(Magic) + 1]byte
+	if _, err := io.ReadFull(player.r, header[:]); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRecording, err)
+	}
+	if string(header[:len(Magic)]) != Magic {
+		return nil, fmt.Errorf("%w: bad signature", ErrInvalidRecording)
+	}
+	if version := header[len(Magic)]; (version == 0) || (version > Version) {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, version)
+	}
+	return player, nil
+}
+
+// Frames returns the number of frames played so far.
+func (player *Player) Frames() int {
+	return player.frame
+}
+
+// NextFrame feeds the input of the next frame into the IO, and sets its delta time and display size.
+// Call it in place of the platform input handling, right before imgui.NewFrame().
+// It returns false at the end of the recording, or if an error occurred.
+func (player *Player) NextFrame() (bool, error) {
+	for {
+		code, err := player.r.ReadByte()
+		if errors.Is(err, io.EOF) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		done, err := player.apply(opcode(code))
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+			return false, fmt.Errorf("%w: frame %d: %v", ErrInvalidRecording, player.frame, err)
+		}
+		if done {
+			player.frame++
+			return true, nil
+		}
+	}
+}
+
+func (player *Player) apply(code opcode) (frameDone bool, err error) {
+	switch code {
+	case opFrame:
+		deltaTime, err := player.float()
+		if err != nil {
+			return false, err
+		}
+		player.io.SetDeltaTime(deltaTime)
+		return true, nil
+	case opDisplaySize:
+		size, err := player.vec2()
+		if err != nil {
+			return false, err
+		}
+		player.io.SetDisplaySize(size)
+	case opKey:
+		key, err := player.uvarint()
+		if err != nil {
+			return false, err
+		}
+		down, err := player.bool()
+		if err != nil {
+			return false, err
+		}
+		player.io.AddKeyEvent(imgui.ImguiKey(key), down)
+	case opKeyAnalog:
+		key, err := player.uvarint()
+		if err != nil {
+			return false, err
+		}
+		down, err := player.bool()
+		if err != nil {
+			return false, err
+		}
+		value, err := player.float()
+		if err != nil {
+			return false, err
+		}
+		player.io.AddKeyAnalogEvent(imgui.ImguiKey(key), down, value)
+	case opMousePos:
+		pos, err := player.vec2()
+		if err != nil {
+			return false, err
+		}
+		player.io.AddMousePosEvent(pos)
+	case opMouseButton:
+		button, err := player.uvarint()
+		if err != nil {
+			return false, err
+		}
+		down, err := player.bool()
+		if err != nil {
+			return false, err
+		}
+		player.io.AddMouseButtonEvent(int(button), down)
+	case opMouseWheel:
+		wheel, err := player.vec2()
+		if err != nil {
+			return false, err
+		}
+		player.io.AddMouseWheelEvent(wheel.X, wheel.Y)
+	case opMouseSource:
+		source, err := player.uvarint()
+		if err != nil {
+			return false, err
+		}
+		player.io.AddMouseSourceEvent(imgui.MouseSource(source))
+	case opFocus:
+		focused, err := player.bool()
+		if err != nil {
+			return false, err
+		}
+		player.io.AddFocusEvent(focused)
+	case opCharacters:
+		length, err := player.uvarint()
+		if err != nil {
+			return false, err
+		}
+		if length > math.MaxInt64 {
+			return false, fmt.Errorf("characters too long: %d", length)
+		}
+		// The characters are copied rather than read into a buffer of the given length,
+		// so that a corrupt length fails at the end of the data, without allocating it first.
+		var chars strings.Builder
+		if _, err := io.CopyN(&chars, player.r, int64(length)); err != nil {
+			return false, err
+		}
+		player.io.AddInputCharacters(chars.String())
+	case opCharacterUTF16:
+		c, err := player.uvarint()
+		if err != nil {
+			return false, err
+		}
+		player.io.AddInputCharacterUTF16(uint16(c))
+	case opClearInputKeys:
+		player.io.ClearInputKeys()
+	case opClearInputMouse:
+		player.io.ClearInputMouse()
+	default:
+		return false, fmt.Errorf("unknown opcode %d", code)
+	}
+	return false, nil
+}
+
+func (player *Player) uvarint() (uint64, error) {
+	return binary.ReadUvarint(player.r)
+}
+
+func (player *Player) bool() (bool, error) {
+	value, err := player.r.ReadByte()
+	return value != 0, err
+}
+
+func (player *Player) float() (float32, error) {
+	var data [4]byte
+	if _, err := io.ReadFull(player.r, data[:]); err != nil {
+		return 0, err
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(data[:])), nil
+}
+
+func (player *Player) vec2() (imgui.Vec2, error) {
+	x, err := player.float()
+	if err != nil {
+		return imgui.Vec2{}, err
+	}
+	y, err := player.float()
+	if err != nil {
+		return imgui.Vec2{}, err
+	}
+	return imgui.Vec2{X: x, Y: y}, nil
+}
+
+// Replay plays a complete recording into the IO of the current context.
+// For every recorded frame, it calls imgui.NewFrame(), the frame function and imgui.Render().
+// It returns the number of frames played.
+func Replay(r io.Reader, frame func()) (int, error) {
+	player, err := NewPlayer(imgui.CurrentIO(), r)
+	if err != nil {
+		return 0, err
+	}
+	for {
+		more, err := player.NextFrame()
+		if err != nil {
+			return player.Frames(), err
+		}
+		if !more {
+			return player.Frames(), nil
+		}
+		imgui.NewFrame()
+		frame()
+		imgui.Render()
+	}
+}
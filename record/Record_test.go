@@ -0,0 +1,169 @@
+package record_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+	"github.com/jetsetilly/imgui-go/v5/record"
+)
+
+type session struct {
+	clicks int
+	text   string
+
+	buttonCenter imgui.Vec2
+	inputCenter  imgui.Vec2
+}
+
+func (s *session) frame() {
+	imgui.SetNextWindowPos(imgui.Vec2{})
+	imgui.SetNextWindowSize(imgui.Vec2{X: 300, Y: 200})
+	imgui.Begin("Session")
+	if imgui.Button("Count") {
+		s.clicks++
+	}
+	s.buttonCenter = itemCenter()
+	imgui.InputText("Name", &s.text)
+	s.inputCenter = itemCenter()
+	imgui.End()
+}
+
+func itemCenter() imgui.Vec2 {
+	min, max := imgui.ItemRectMin(), imgui.ItemRectMax()
+	return imgui.Vec2{X: (min.X + max.X) / 2, Y: (min.Y + max.Y) / 2}
+}
+
+func newContext() *imgui.Context {
+	context := imgui.CreateContext(nil)
+	io := imgui.CurrentIO()
+	io.SetIniFilename("")
+	io.Fonts().TextureDataRGBA32()
+	return context
+}
+
+func TestReplayReproducesSession(t *testing.T) {
+	var recording bytes.Buffer
+	recorded := &session{}
+	var recordedTime float64
+
+	func() {
+		context := newContext()
+		defer context.Destroy()
+
+		recorder, err := record.NewRecorder(imgui.CurrentIO(), &recording)
+		require.NoError(t, err)
+		step := func() {
+			recorder.SetDisplaySize(imgui.Vec2{X: 640, Y: 480})
+			recorder.SetDeltaTime(0.02)
+			recorder.Frame()
+			imgui.NewFrame()
+			recorded.frame()
+			imgui.Render()
+		}
+		click := func(pos imgui.Vec2) {
+			recorder.AddMousePosEvent(pos)
+			recorder.AddMouseButtonEvent(0, true)
+			step()
+			recorder.AddMouseButtonEvent(0, false)
+			step()
+		}
+
+		step()
+		step()
+		click(recorded.buttonCenter)
+		click(recorded.buttonCenter)
+		click(recorded.inputCenter)
+		recorder.AddInputCharacters("Gopher")
+		step()
+		recorder.AddKeyEvent(imgui.KeyBackspace, true)
+		step()
+		recorder.AddKeyEvent(imgui.KeyBackspace, false)
+		step()
+		require.NoError(t, recorder.Close())
+		recordedTime = imgui.Time()
+	}()
+	require.Equal(t, 2, recorded.clicks)
+	require.Equal(t, "Gophe", recorded.text)
+
+	context := newContext()
+	defer context.Destroy()
+	replayed := &session{}
+	frames, err := record.Replay(bytes.NewReader(recording.Bytes()), replayed.frame)
+	require.NoError(t, err)
+
+	assert.Equal(t, 11, frames)
+	assert.Equal(t, recorded.clicks, replayed.clicks)
+	assert.Equal(t, recorded.text, replayed.text)
+	assert.Equal(t, recordedTime, imgui.Time())
+	assert.True(t, recording.Len() < 200, "recording should be compact")
+}
+
+func TestPlayerRejectsInvalidData(t *testing.T) {
+	context := newContext()
+	defer context.Destroy()
+
+	_, err := record.NewPlayer(imgui.CurrentIO(), bytes.NewReader([]byte("PNG")))
+	assert.True(t, errors.Is(err, record.ErrInvalidRecording))
+
+	_, err = record.NewPlayer(imgui.CurrentIO(), bytes.NewReader([]byte(record.Magic+"\x09")))
+	assert.True(t, errors.Is(err, record.ErrUnsupportedVersion))
+
+	player, err := record.NewPlayer(imgui.CurrentIO(), bytes.NewReader([]byte(record.Magic+"\x01\x05\x00")))
+	require.NoError(t, err)
+	more, err := player.NextFrame()
+	assert.False(t, more)
+	assert.True(t, errors.Is(err, record.ErrInvalidRecording), "truncated record should be reported")
+
+	player, err = record.NewPlayer(imgui.CurrentIO(), bytes.NewReader([]byte(record.Magic+"\x01\xff")))
+	require.NoError(t, err)
+	_, err = player.NextFrame()
+	assert.True(t, errors.Is(err, record.ErrInvalidRecording), "unknown opcode should be reported")
+}
+
+func TestReplayReproducesLongCharacterInput(t *testing.T) {
+	var recording bytes.Buffer
+	recorded := &session{}
+	text := strings.Repeat("Gopher ", 1000)
+
+	func() {
+		context := newContext()
+		defer context.Destroy()
+
+		recorder, err := record.NewRecorder(imgui.CurrentIO(), &recording)
+		require.NoError(t, err)
+		step := func() {
+			recorder.SetDisplaySize(imgui.Vec2{X: 640, Y: 480})
+			recorder.SetDeltaTime(0.02)
+			recorder.Frame()
+			imgui.NewFrame()
+			recorded.frame()
+			imgui.Render()
+		}
+
+		step()
+		step()
+		recorder.AddMousePosEvent(recorded.inputCenter)
+		recorder.AddMouseButtonEvent(0, true)
+		step()
+		recorder.AddMouseButtonEvent(0, false)
+		step()
+		recorder.AddInputCharacters(text)
+		step()
+		require.NoError(t, recorder.Close())
+	}()
+	require.Equal(t, text, recorded.text)
+	require.True(t, recording.Len() > 4096, "characters should exceed the read buffer of the player")
+
+	context := newContext()
+	defer context.Destroy()
+	replayed := &session{}
+	_, err := record.Replay(bytes.NewReader(recording.Bytes()), replayed.frame)
+	require.NoError(t, err)
+	assert.Equal(t, text, replayed.text)
+}
@@ -0,0 +1,209 @@
+package record
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// Recorder forwards input to an imgui.IO, and writes it to a recording.
+// Its methods mirror the input functions of imgui.IO.
+//
+// Writing is buffered. Errors are sticky: after the first failed write, nothing is written anymore,
+// while the input is still forwarded. The error is returned by Err() and Close().
+type Recorder struct {
+	io  imgui.IO
+	w   *bufio.Writer
+	err error
+
+	buf []byte
+
+	deltaTime          float32
+	displaySize        imgui.Vec2
+	displaySizeKnown   bool
+	displaySizePending bool
+}
+
+// NewRecorder returns a recorder that forwards input to the given IO and writes the recording to w.
+// The delta time of the frames defaults to 1/60 of a second.
+func NewRecorder(target imgui.IO, w io.Writer) (*Recorder, error) {
+	recorder := &Recorder{
+		io:        target,
+		w:         bufio.NewWriter(w),
+		deltaTime: 1.0 / 60.0,
+	}
+	recorder.buf = append(recorder.buf, Magic...)
+	recorder.buf = append(recorder.buf, Version)
+	recorder.flushRecord()
+	return recorder, recorder.err
+}
+
+// Err returns the first error that occurred while writing.
+func (recorder *Recorder) Err() error {
+	return recorder.err
+}
+
+// Close writes any buffered data. It does not close the underlying writer.
+func (recorder *Recorder) Close() error {
+	if recorder.err == nil {
+		recorder.err = recorder.w.Flush()
+	}
+	return recorder.err
+}
+
+// Frame ends the input of the current frame. Call it once per frame, right before imgui.NewFrame().
+// It records the delta time and, if it has changed, the display size.
+func (recorder *Recorder) Frame() {
+	if recorder.displaySizePending {
+		recorder.op(opDisplaySize)
+		recorder.vec2(recorder.displaySize)
+		recorder.flushRecord()
+		recorder.displaySizePending = false
+	}
+	recorder.op(opFrame)
+	recorder.float(recorder.deltaTime)
+	recorder.flushRecord()
+}
+
+// SetDisplaySize forwards to imgui.IO.SetDisplaySize().
+func (recorder *Recorder) SetDisplaySize(value imgui.Vec2) {
+	recorder.io.SetDisplaySize(value)
+	if !recorder.displaySizeKnown || (value != recorder.displaySize) {
+		recorder.displaySize = value
+		recorder.displaySizeKnown = true
+		recorder.displaySizePending = true
+	}
+}
+
+// SetDeltaTime forwards to imgui.IO.SetDeltaTime().
+func (recorder *Recorder) SetDeltaTime(value float32) {
+	recorder.io.SetDeltaTime(value)
+	recorder.deltaTime = value
+}
+
+// AddKeyEvent forwards to imgui.IO.AddKeyEvent().
+func (recorder *Recorder) AddKeyEvent(key imgui.ImguiKey, down bool) {
+	recorder.io.AddKeyEvent(key, down)
+	recorder.op(opKey)
+	recorder.uvarint(uint64(key))
+	recorder.bool(down)
+	recorder.flushRecord()
+}
+
+// AddKeyAnalogEvent forwards to imgui.IO.AddKeyAnalogEvent().
+func (recorder *Recorder) AddKeyAnalogEvent(key imgui.ImguiKey, down bool, value float32) {
+	recorder.io.AddKeyAnalogEvent(key, down, value)
+	recorder.op(opKeyAnalog)
+	recorder.uvarint(uint64(key))
+	recorder.bool(down)
+	recorder.float(value)
+	recorder.flushRecord()
+}
+
+// AddMousePosEvent forwards to imgui.IO.AddMousePosEvent().
+func (recorder *Recorder) AddMousePosEvent(pos imgui.Vec2) {
+	recorder.io.AddMousePosEvent(pos)
+	recorder.op(opMousePos)
+	recorder.vec2(pos)
+	recorder.flushRecord()
+}
+
+// AddMouseButtonEvent forwards to imgui.IO.AddMouseButtonEvent().
+func (recorder *Recorder) AddMouseButtonEvent(button int, down bool) {
+	recorder.io.AddMouseButtonEvent(button, down)
+	recorder.op(opMouseButton)
+	recorder.uvarint(uint64(button))
+	recorder.bool(down)
+	recorder.flushRecord()
+}
+
+// AddMouseWheelEvent forwards to imgui.IO.AddMouseWheelEvent().
+func (recorder *Recorder) AddMouseWheelEvent(wheelX, wheelY float32) {
+	recorder.io.AddMouseWheelEvent(wheelX, wheelY)
+	recorder.op(opMouseWheel)
+	recorder.float(wheelX)
+	recorder.float(wheelY)
+	recorder.flushRecord()
+}
+
+// AddMouseSourceEvent forwards to imgui.IO.AddMouseSourceEvent().
+func (recorder *Recorder) AddMouseSourceEvent(source imgui.MouseSource) {
+	recorder.io.AddMouseSourceEvent(source)
+	recorder.op(opMouseSource)
+	recorder.uvarint(uint64(source))
+	recorder.flushRecord()
+}
+
+// AddFocusEvent forwards to imgui.IO.AddFocusEvent().
+func (recorder *Recorder) AddFocusEvent(focused bool) {
+	recorder.io.AddFocusEvent(focused)
+	recorder.op(opFocus)
+	recorder.bool(focused)
+	recorder.flushRecord()
+}
+
+// AddInputCharacters forwards to imgui.IO.AddInputCharacters().
+func (recorder *Recorder) AddInputCharacters(chars string) {
+	recorder.io.AddInputCharacters(chars)
+	recorder.op(opCharacters)
+	recorder.uvarint(uint64(len(chars)))
+	recorder.buf = append(recorder.buf, chars...)
+	recorder.flushRecord()
+}
+
+// AddInputCharacterUTF16 forwards to imgui.IO.AddInputCharacterUTF16().
+func (recorder *Recorder) AddInputCharacterUTF16(c uint16) {
+	recorder.io.AddInputCharacterUTF16(c)
+	recorder.op(opCharacterUTF16)
+	recorder.uvarint(uint64(c))
+	recorder.flushRecord()
+}
+
+// ClearInputKeys forwards to imgui.IO.ClearInputKeys().
+func (recorder *Recorder) ClearInputKeys() {
+	recorder.io.ClearInputKeys()
+	recorder.op(opClearInputKeys)
+	recorder.flushRecord()
+}
+
+// ClearInputMouse forwards to imgui.IO.ClearInputMouse().
+func (recorder *Recorder) ClearInputMouse() {
+	recorder.io.ClearInputMouse()
+	recorder.op(opClearInputMouse)
+	recorder.flushRecord()
+}
+
+func (recorder *Recorder) op(code opcode) {
+	recorder.buf = append(recorder.buf, byte(code))
+}
+
+func (recorder *Recorder) uvarint(value uint64) {
+	recorder.buf = binary.AppendUvarint(recorder.buf, value)
+}
+
+func (recorder *Recorder) bool(value bool) {
+	if value {
+		recorder.buf = append(recorder.buf, 1)
+	} else {
+		recorder.buf = append(recorder.buf, 0)
+	}
+}
+
+func (recorder *Recorder) float(value float32) {
+	recorder.buf = binary.LittleEndian.AppendUint32(recorder.buf, math.Float32bits(value))
+}
+
+func (recorder *Recorder) vec2(value imgui.Vec2) {
+	recorder.float(value.X)
+	recorder.float(value.Y)
+}
+
+func (recorder *Recorder) flushRecord() {
+	if recorder.err == nil {
+		_, recorder.err = recorder.w.Write(recorder.buf)
+	}
+	recorder.buf = recorder.buf[:0]
+}
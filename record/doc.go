@@ -0,0 +1,46 @@
+// Package record records the input of an application and replays it deterministically.
+//
+// A Recorder wraps an imgui.IO. The application passes its input through the recorder instead of the IO,
+// and calls Frame() before every imgui.NewFrame():
+//
+//	recorder, err := record.NewRecorder(imgui.CurrentIO(), file)
+//	// ...
+//	for running {
+//		// platform events, forwarded to recorder.AddMousePosEvent(), recorder.AddKeyEvent(), ...
+//		recorder.SetDisplaySize(windowSize)
+//		recorder.SetDeltaTime(deltaTime)
+//		recorder.Frame()
+//		imgui.NewFrame()
+//		// ...
+//		imgui.Render()
+//	}
+//	err = recorder.Close()
+//
+// A Player feeds a recording back into an IO, frame by frame and in the original order.
+// As the delta time of every frame is part of the recording, imgui.Time() and all timing
+// based behaviour, such as double-clicks and key repeats, are reproduced as well.
+// Replay() runs a complete recording headlessly, which is useful to reproduce a bug report in a test.
+//
+// # File format
+//
+// A recording starts with the four bytes "IGRC", followed by the format version as one byte.
+// The remainder is a sequence of records, each starting with an opcode byte:
+//
+//	opcode  record               payload
+//	1       frame                delta time (float32)
+//	2       display size         width, height (float32)
+//	3       key                  key (uvarint), down (byte)
+//	4       key analog           key (uvarint), down (byte), value (float32)
+//	5       mouse position       x, y (float32)
+//	6       mouse button         button (uvarint), down (byte)
+//	7       mouse wheel          x, y (float32)
+//	8       mouse source         source (uvarint)
+//	9       focus                focused (byte)
+//	10      characters           length (uvarint), UTF-8 bytes
+//	11      UTF-16 character     code unit (uvarint)
+//	12      clear input keys     -
+//	13      clear input mouse    -
+//
+// Floats are stored in IEEE 754 format, little endian. All records up to a frame record belong to that frame.
+// The display size is only recorded when it changes.
+package record
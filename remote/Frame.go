@@ -0,0 +1,162 @@
+package remote
+
+import (
+	"encoding/binary"
+	"math"
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// FrameVersion is the version of the frame encoding. It changes whenever the client can no longer
+// decode the frames of an older or newer server.
+const FrameVersion = 1
+
+// The kinds of messages that the server sends. The kind is the first byte of every binary message.
+const (
+	// messageTexture is followed by the texture number, width and height as uint32, and the NRGBA pixels.
+	messageTexture = 'T'
+	// messageKeyFrame is followed by a complete frame.
+	messageKeyFrame = 'K'
+	// messageDelta is followed by the changes to the previous frame, see appendDelta().
+	messageDelta = 'D'
+)
+
+// frameEncoder encodes draw data into the frame format that the client renders.
+//
+// All values are little endian and 4-byte aligned, so that the client can view them as typed arrays:
+//
+//	header:   version, index size (2 or 4), display pos, display size, frame buffer scale, list count
+//	list:     vertex count, index count, command count,
+//	          vertices (x, y, u, v as float32, color as uint32), indices padded to 4 bytes,
+//	          commands (element count, index offset, vertex offset, texture number, clip rect as 4 float32)
+//
+// Texture numbers are assigned by the server, starting at 1, and sent to the clients with messageTexture.
+type frameEncoder struct {
+	indexSize int
+
+	buf []byte
+}
+
+func newFrameEncoder() *frameEncoder {
+	return &frameEncoder{indexSize: int(unsafe.Sizeof(imgui.DrawIdx(0)))}
+}
+
+// encode returns the encoded draw data. The returned slice is reused by the next call.
+// Commands with a user callback, or with a texture that has no number, are left out.
+func (encoder *frameEncoder) encode(data imgui.DrawData, textures map[imgui.TextureID]uint32) []byte {
+	encoder.buf = encoder.buf[:0]
+	encoder.putUint32(FrameVersion)
+	encoder.putUint32(uint32(encoder.indexSize))
+	if !data.Valid() {
+		for i := 0; i < 6; i++ {
+			encoder.putFloat32(0)
+		}
+		encoder.putUint32(0)
+		return encoder.buf
+	}
+	encoder.putVec2(data.DisplayPos())
+	encoder.putVec2(data.DisplaySize())
+	encoder.putVec2(data.FrameBufferScale())
+
+	lists := data.CommandLists()
+	encoder.putUint32(uint32(len(lists)))
+	for _, list := range lists {
+		vertices := list.Vertices()
+		indices := list.Indices()
+		commands := list.Commands()
+		countPos := len(encoder.buf)
+		encoder.putUint32(uint32(len(vertices)))
+		encoder.putUint32(uint32(len(indices)))
+		encoder.putUint32(0)
+
+		for _, vertex := range vertices {
+			encoder.putVec2(vertex.Pos)
+			encoder.putVec2(vertex.UV)
+			encoder.putUint32(uint32(vertex.Col))
+		}
+		for _, index := range indices {
+			if encoder.indexSize == 2 {
+				encoder.buf = binary.LittleEndian.AppendUint16(encoder.buf, uint16(index))
+			} else {
+				encoder.putUint32(uint32(index))
+			}
+		}
+		for len(encoder.buf)%4 != 0 {
+			encoder.buf = append(encoder.buf, 0)
+		}
+
+		written := 0
+		for _, cmd := range commands {
+			if cmd.HasUserCallback() {
+				continue
+			}
+			texture, registered := textures[cmd.TextureID()]
+			if !registered {
+				continue
+			}
+			encoder.putUint32(uint32(cmd.ElementCount()))
+			encoder.putUint32(uint32(cmd.IndexOffset()))
+			encoder.putUint32(uint32(cmd.VertexOffset()))
+			encoder.putUint32(texture)
+			clip := cmd.ClipRect()
+			encoder.putFloat32(clip.X)
+			encoder.putFloat32(clip.Y)
+			encoder.putFloat32(clip.Z)
+			encoder.putFloat32(clip.W)
+			written++
+		}
+		binary.LittleEndian.PutUint32(encoder.buf[countPos+8:], uint32(written))
+	}
+	return encoder.buf
+}
+
+func (encoder *frameEncoder) putUint32(value uint32) {
+	encoder.buf = binary.LittleEndian.AppendUint32(encoder.buf, value)
+}
+
+func (encoder *frameEncoder) putFloat32(value float32) {
+	encoder.putUint32(math.Float32bits(value))
+}
+
+func (encoder *frameEncoder) putVec2(value imgui.Vec2) {
+	encoder.putFloat32(value.X)
+	encoder.putFloat32(value.Y)
+}
+
+// deltaBlockSize is the granularity at which frames are compared.
+const deltaBlockSize = 16
+
+// appendDelta appends the changes from previous to next, which the client applies with its copy of previous.
+//
+// The delta is the length of next as uvarint, followed by operations until next is complete.
+// Each operation is an uvarint of (count << 1 | literal). An operation without the literal bit copies
+// count bytes from previous, at the current position. An operation with the literal bit is followed by
+// count bytes that replace them.
+func appendDelta(dst, previous, next []byte) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(next)))
+	literal := false
+	start := 0
+	flush := func(end int) {
+		if end == start {
+			return
+		}
+		if literal {
+			dst = binary.AppendUvarint(dst, uint64(end-start)<<1|1)
+			dst = append(dst, next[start:end]...)
+		} else {
+			dst = binary.AppendUvarint(dst, uint64(end-start)<<1)
+		}
+		start = end
+	}
+	for pos := 0; pos < len(next); pos += deltaBlockSize {
+		end := min(pos+deltaBlockSize, len(next))
+		same := (end <= len(previous)) && (string(next[pos:end]) == string(previous[pos:end]))
+		if same == literal {
+			flush(pos)
+			literal = !same
+		}
+	}
+	flush(len(next))
+	return dst
+}
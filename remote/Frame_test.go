@@ -0,0 +1,62 @@
+package remote // nolint: testpackage
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// applyDelta mirrors the decoder of the client.
+func applyDelta(t *testing.T, previous, delta []byte) []byte {
+	length, n := binary.Uvarint(delta)
+	require.True(t, n > 0)
+	delta = delta[n:]
+	var next []byte
+	for uint64(len(next)) < length {
+		op, n := binary.Uvarint(delta)
+		require.True(t, n > 0)
+		delta = delta[n:]
+		count := int(op >> 1)
+		if op&1 != 0 {
+			next = append(next, delta[:count]...)
+			delta = delta[count:]
+		} else {
+			next = append(next, previous[len(next):len(next)+count]...)
+		}
+	}
+	assert.Empty(t, delta, "delta should be consumed completely")
+	return next
+}
+
+func TestDeltaRoundTrip(t *testing.T) {
+	previous := bytes.Repeat([]byte("0123456789abcdef"), 20)
+	changed := append([]byte{}, previous...)
+	changed[40] = 'x'
+	changed[200] = 'y'
+
+	tests := []struct {
+		name string
+		next []byte
+	}{
+		{"unchanged", previous},
+		{"changed", changed},
+		{"longer", append(append([]byte{}, changed...), "tail"...)},
+		{"shorter", previous[:50]},
+		{"empty", nil},
+	}
+	for _, test := range tests {
+		delta := appendDelta(nil, previous, test.next)
+		assert.Equal(t, string(test.next), string(applyDelta(t, previous, delta)), test.name)
+	}
+
+	assert.True(t, len(appendDelta(nil, previous, previous)) < 8, "unchanged frame should be a few bytes")
+	assert.True(t, len(appendDelta(nil, previous, changed)) < 2*deltaBlockSize+16, "only changed blocks should be sent")
+}
+
+func TestAcceptKey(t *testing.T) {
+	// the example of RFC 6455
+	assert.Equal(t, "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=", acceptKey("dGhlIHNhbXBsZSBub25jZQ=="))
+}
@@ -0,0 +1,132 @@
+package remote
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// inputEvent is an input event of the client, sent as a JSON text message.
+// The type selects which of the other fields are used:
+//
+//	"mouse":  X, Y        the mouse position, in display coordinates
+//	"button": Button, Down
+//	"wheel":  X, Y        the wheel movement, positive for left and up
+//	"key":    Code, Down  the DOM KeyboardEvent.code of the key
+//	"text":   Text        characters typed
+//	"focus":  Focused     whether the page has the focus
+//
+// Every event also carries the state of the modifier keys.
+type inputEvent struct {
+	Type    string  `json:"type"`
+	X       float32 `json:"x"`
+	Y       float32 `json:"y"`
+	Button  int     `json:"button"`
+	Down    bool    `json:"down"`
+	Code    string  `json:"code"`
+	Text    string  `json:"text"`
+	Focused bool    `json:"focused"`
+	Ctrl    bool    `json:"ctrl"`
+	Shift   bool    `json:"shift"`
+	Alt     bool    `json:"alt"`
+	Super   bool    `json:"super"`
+}
+
+func decodeInputEvent(payload []byte) (inputEvent, error) {
+	var event inputEvent
+	err := json.Unmarshal(payload, &event)
+	return event, err
+}
+
+// mods returns the modifier keys that are held during the event.
+func (event inputEvent) mods() imgui.ImguiKey {
+	mods := imgui.KeyModNone
+	if event.Ctrl {
+		mods |= imgui.KeyModCtrl
+	}
+	if event.Shift {
+		mods |= imgui.KeyModShift
+	}
+	if event.Alt {
+		mods |= imgui.KeyModAlt
+	}
+	if event.Super {
+		mods |= imgui.KeyModSuper
+	}
+	return mods
+}
+
+// inputState applies the events of all clients to the IO, and tracks the modifier keys across them.
+type inputState struct {
+	mods imgui.ImguiKey
+}
+
+func (state *inputState) apply(target imgui.IO, event inputEvent) {
+	if event.Type == "focus" {
+		target.AddFocusEvent(event.Focused)
+		if !event.Focused {
+			state.mods = imgui.KeyModNone
+		}
+		return
+	}
+
+	mods := event.mods()
+	for _, mod := range []imgui.ImguiKey{imgui.KeyModCtrl, imgui.KeyModShift, imgui.KeyModAlt, imgui.KeyModSuper} {
+		if (mods & mod) != (state.mods & mod) {
+			target.AddKeyEvent(mod, mods&mod != 0)
+		}
+	}
+	state.mods = mods
+
+	switch event.Type {
+	case "mouse":
+		target.AddMousePosEvent(imgui.Vec2{X: event.X, Y: event.Y})
+	case "button":
+		if (event.Button >= 0) && (event.Button < 5) {
+			target.AddMouseButtonEvent(event.Button, event.Down)
+		}
+	case "wheel":
+		target.AddMouseWheelEvent(event.X, event.Y)
+	case "key":
+		if key, known := domKeys[event.Code]; known {
+			target.AddKeyEvent(key, event.Down)
+		}
+	case "text":
+		target.AddInputCharacters(event.Text)
+	}
+}
+
+// domKeys maps the values of the DOM KeyboardEvent.code to keys.
+var domKeys = map[string]imgui.ImguiKey{
+	"Tab": imgui.KeyTab, "ArrowLeft": imgui.KeyLeftArrow, "ArrowRight": imgui.KeyRightArrow,
+	"ArrowUp": imgui.KeyUpArrow, "ArrowDown": imgui.KeyDownArrow,
+	"PageUp": imgui.KeyPageUp, "PageDown": imgui.KeyPageDown, "Home": imgui.KeyHome, "End": imgui.KeyEnd,
+	"Insert": imgui.KeyInsert, "Delete": imgui.KeyDelete, "Backspace": imgui.KeyBackspace,
+	"Space": imgui.KeySpace, "Enter": imgui.KeyEnter, "Escape": imgui.KeyEscape,
+	"ControlLeft": imgui.KeyLeftCtrl, "ShiftLeft": imgui.KeyLeftShift, "AltLeft": imgui.KeyLeftAlt,
+	"MetaLeft": imgui.KeyLeftSuper, "ControlRight": imgui.KeyRightCtrl, "ShiftRight": imgui.KeyRightShift,
+	"AltRight": imgui.KeyRightAlt, "MetaRight": imgui.KeyRightSuper, "ContextMenu": imgui.KeyMenu,
+	"Quote": imgui.KeyApostrophe, "Comma": imgui.KeyComma, "Minus": imgui.KeyMinus, "Period": imgui.KeyPeriod,
+	"Slash": imgui.KeySlash, "Semicolon": imgui.KeySemicolon, "Equal": imgui.KeyEqual,
+	"BracketLeft": imgui.KeyLeftBracket, "Backslash": imgui.KeyBackslash, "BracketRight": imgui.KeyRightBracket,
+	"Backquote": imgui.KeyGraveAccent, "CapsLock": imgui.KeyCapsLock, "ScrollLock": imgui.KeyScrollLock,
+	"NumLock": imgui.KeyNumLock, "PrintScreen": imgui.KeyPrintScreen, "Pause": imgui.KeyPause,
+	"NumpadDecimal": imgui.KeyKeypadDecimal, "NumpadDivide": imgui.KeyKeypadDivide,
+	"NumpadMultiply": imgui.KeyKeypadMultiply, "NumpadSubtract": imgui.KeyKeypadSubtract,
+	"NumpadAdd": imgui.KeyKeypadAdd, "NumpadEnter": imgui.KeyKeypadEnter, "NumpadEqual": imgui.KeyKeypadEqual,
+	"IntlBackslash": imgui.KeyOem102,
+}
+
+func init() {
+	for i := 0; i < 26; i++ {
+		domKeys["Key"+string(rune('A'+i))] = imgui.KeyA + imgui.ImguiKey(i)
+	}
+	for i := 0; i < 10; i++ {
+		domKeys["Digit"+string(rune('0'+i))] = imgui.Key0 + imgui.ImguiKey(i)
+		domKeys["Numpad"+string(rune('0'+i))] = imgui.KeyKeypad0 + imgui.ImguiKey(i)
+	}
+	for i := 0; i < 24; i++ {
+		domKeys["F"+strconv.Itoa(i+1)] = imgui.KeyF1 + imgui.ImguiKey(i)
+	}
+}
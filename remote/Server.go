@@ -0,0 +1,309 @@
+package remote
+
+import (
+	"embed"
+	"encoding/binary"
+	"image"
+	"image/draw"
+	"io/fs"
+	"net/http"
+	"path"
+	"sync"
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+//go:embed client
+var clientFiles embed.FS
+
+// Server streams the rendered frames to browsers, and collects their input.
+//
+// Server is an http.Handler: the path "ws" below the path it is served at is the WebSocket
+// endpoint, all other paths serve the bundled client.
+//
+// Publish(), ApplyInput() and the texture functions must be called from the goroutine that runs the frames.
+// The handler is safe for use by the HTTP server goroutines.
+type Server struct {
+	target  imgui.IO
+	encoder *frameEncoder
+	files   http.Handler
+	input   inputState
+
+	lock            sync.Mutex
+	textures        map[imgui.TextureID]uint32
+	textureMessages map[uint32][]byte
+	allowedHosts    map[string]struct{}
+	frame           []byte
+	clients         map[*client]struct{}
+	events          []inputEvent
+}
+
+// client is a connected browser.
+type client struct {
+	ws     *websocketConn
+	notify chan struct{}
+	done   chan struct{}
+
+	// guarded by the lock of the server
+	queue    [][]byte
+	next     []byte
+	keyFrame bool
+}
+
+// NewServer returns a server that adds the input of its clients to the given IO.
+// No textures are registered; call RegisterFontAtlas() for the clients to show text.
+func NewServer(target imgui.IO) *Server {
+	files, _ := fs.Sub(clientFiles, "client")
+	return &Server{
+		target:          target,
+		encoder:         newFrameEncoder(),
+		files:           http.FileServer(http.FS(files)),
+		textures:        make(map[imgui.TextureID]uint32),
+		textureMessages: make(map[uint32][]byte),
+		allowedHosts:    make(map[string]struct{}),
+		clients:         make(map[*client]struct{}),
+	}
+}
+
+// AllowHosts accepts requests for the given host names, in addition to localhost and the loopback addresses.
+// Requests for other host names are rejected. Allow the names under which the server is reached from
+// other machines, such as the name of the build server, or the name a proxy forwards.
+func (server *Server) AllowHosts(names ...string) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	for _, name := range names {
+		server.allowedHosts[hostName(name)] = struct{}{}
+	}
+}
+
+// trustedHost reports whether the host of the request is a loopback host, or allowed by AllowHosts().
+func (server *Server) trustedHost(host string) bool {
+	name := hostName(host)
+	if loopbackHost(name) {
+		return true
+	}
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	_, allowed := server.allowedHosts[name]
+	return allowed
+}
+
+// RegisterFontAtlas sends the RGBA32 texture data of the font atlas to the clients, using the texture ID of the atlas.
+// The atlas must be registered again if its texture ID or its fonts change.
+func (server *Server) RegisterFontAtlas(atlas imgui.FontAtlas) {
+	data := atlas.TextureDataRGBA32()
+	var pixels []byte
+	if data.Pixels != nil {
+		pixels = unsafe.Slice((*byte)(data.Pixels), data.Width*data.Height*4)
+	}
+	server.registerPixels(atlas.TextureID(), data.Width, data.Height, pixels)
+}
+
+// RegisterTexture sends the image to the clients, for draw commands that refer to the given texture ID.
+// Registering an image for an ID that is already in use replaces the previous image.
+func (server *Server) RegisterTexture(id imgui.TextureID, img image.Image) {
+	bounds := img.Bounds()
+	texture := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(texture, texture.Bounds(), img, bounds.Min, draw.Src)
+	server.registerPixels(id, bounds.Dx(), bounds.Dy(), texture.Pix)
+}
+
+// UnregisterTexture removes the texture of the given ID.
+// Draw commands that refer to an unregistered texture are not sent.
+func (server *Server) UnregisterTexture(id imgui.TextureID) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	if number, registered := server.textures[id]; registered {
+		delete(server.textures, id)
+		delete(server.textureMessages, number)
+	}
+}
+
+func (server *Server) registerPixels(id imgui.TextureID, width, height int, pixels []byte) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	number, registered := server.textures[id]
+	if !registered {
+		number = uint32(len(server.textures) + 1)
+		for server.textureMessages[number] != nil {
+			number++
+		}
+		server.textures[id] = number
+	}
+
+	message := make([]byte, 0, 13+len(pixels))
+	message = append(message, messageTexture)
+	message = binary.LittleEndian.AppendUint32(message, number)
+	message = binary.LittleEndian.AppendUint32(message, uint32(width))
+	message = binary.LittleEndian.AppendUint32(message, uint32(height))
+	message = append(message, pixels...)
+	server.textureMessages[number] = message
+	for c := range server.clients {
+		c.queue = append(c.queue, message)
+		c.wake()
+	}
+}
+
+// Publish sends the draw data to the clients. A client that is up to date receives the changes
+// to the previous frame; a client that just connected, or that did not keep up, receives the complete frame.
+// Without clients, the draw data is not encoded at all.
+func (server *Server) Publish(data imgui.DrawData) {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	if len(server.clients) == 0 {
+		server.frame = nil
+		return
+	}
+
+	frame := server.encoder.encode(data, server.textures)
+	var keyFrame, delta []byte
+	for c := range server.clients {
+		if c.keyFrame || (c.next != nil) || (server.frame == nil) {
+			if keyFrame == nil {
+				keyFrame = append([]byte{messageKeyFrame}, frame...)
+			}
+			c.next = keyFrame
+			c.keyFrame = false
+		} else {
+			if delta == nil {
+				delta = appendDelta([]byte{messageDelta}, server.frame, frame)
+			}
+			c.next = delta
+		}
+		c.wake()
+	}
+	server.frame = append(server.frame[:0], frame...)
+}
+
+// ApplyInput adds the input events that the clients sent since the last call to the IO.
+// Call it before NewFrame().
+func (server *Server) ApplyInput() {
+	server.lock.Lock()
+	events := server.events
+	server.events = nil
+	server.lock.Unlock()
+
+	for _, event := range events {
+		server.input.apply(server.target, event)
+	}
+}
+
+// Clients returns the number of connected clients.
+func (server *Server) Clients() int {
+	server.lock.Lock()
+	defer server.lock.Unlock()
+	return len(server.clients)
+}
+
+// Close disconnects all clients. The server can still accept new clients afterwards.
+func (server *Server) Close() {
+	server.lock.Lock()
+	clients := make([]*client, 0, len(server.clients))
+	for c := range server.clients {
+		clients = append(clients, c)
+	}
+	server.lock.Unlock()
+
+	for _, c := range clients {
+		c.ws.Close()
+	}
+}
+
+// ServeHTTP serves the client, and accepts WebSocket connections at the path "ws".
+// Only requests for trusted hosts are served, see AllowHosts().
+func (server *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !server.trustedHost(r.Host) {
+		http.Error(w, "host not allowed", http.StatusForbidden)
+		return
+	}
+	if path.Base(r.URL.Path) != "ws" {
+		server.files.ServeHTTP(w, r)
+		return
+	}
+	ws, err := upgradeWebSocket(w, r)
+	if err != nil {
+		return
+	}
+
+	c := &client{ws: ws, notify: make(chan struct{}, 1), done: make(chan struct{}), keyFrame: true}
+	server.lock.Lock()
+	for number := uint32(1); len(c.queue) < len(server.textureMessages); number++ {
+		if message := server.textureMessages[number]; message != nil {
+			c.queue = append(c.queue, message)
+		}
+	}
+	if server.frame != nil {
+		c.next = append([]byte{messageKeyFrame}, server.frame...)
+		c.keyFrame = false
+	}
+	server.clients[c] = struct{}{}
+	c.wake()
+	server.lock.Unlock()
+
+	go server.write(c)
+	server.read(c)
+
+	server.lock.Lock()
+	delete(server.clients, c)
+	server.lock.Unlock()
+	close(c.done)
+	ws.Close()
+}
+
+// read collects the input events of the client until the connection fails or is closed.
+func (server *Server) read(c *client) {
+	for {
+		opcode, payload, err := c.ws.readMessage()
+		if err != nil {
+			return
+		}
+		if opcode != opText {
+			continue
+		}
+		event, err := decodeInputEvent(payload)
+		if err != nil {
+			continue
+		}
+		server.lock.Lock()
+		server.events = append(server.events, event)
+		server.lock.Unlock()
+	}
+}
+
+// write sends the queued textures and the latest frame whenever the client is woken.
+func (server *Server) write(c *client) {
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-c.notify:
+		}
+
+		server.lock.Lock()
+		queue, next := c.queue, c.next
+		c.queue, c.next = nil, nil
+		server.lock.Unlock()
+
+		for _, message := range queue {
+			if err := c.ws.writeMessage(opBinary, message); err != nil {
+				c.ws.Close()
+				return
+			}
+		}
+		if next != nil {
+			if err := c.ws.writeMessage(opBinary, next); err != nil {
+				c.ws.Close()
+				return
+			}
+		}
+	}
+}
+
+// wake makes the writer of the client send what is queued. The lock of the server must be held.
+func (c *client) wake() {
+	select {
+	case c.notify <- struct{}{}:
+	default:
+	}
+}
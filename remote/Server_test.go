@@ -0,0 +1,196 @@
+package remote_test
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+	"github.com/jetsetilly/imgui-go/v5/remote"
+)
+
+// testClient is a minimal WebSocket client.
+type testClient struct {
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+func dial(t *testing.T, server *httptest.Server, origin string) (*testClient, *http.Response) {
+	return dialHost(t, server, strings.TrimPrefix(server.URL, "http://"), origin)
+}
+
+func dialHost(t *testing.T, server *httptest.Server, host, origin string) (*testClient, *http.Response) {
+	conn, err := net.Dial("tcp", strings.TrimPrefix(server.URL, "http://"))
+	require.NoError(t, err)
+	request := "GET /ws HTTP/1.1\r\nHost: " + host + "\r\n" +
+		"Upgrade: websocket\r\nConnection: Upgrade\r\nSec-WebSocket-Version: 13\r\n" +
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n"
+	if origin != "" {
+		request += "Origin: " + origin + "\r\n"
+	}
+	_, err = conn.Write([]byte(request + "\r\n"))
+	require.NoError(t, err)
+	reader := bufio.NewReader(conn)
+	response, err := http.ReadResponse(reader, nil)
+	require.NoError(t, err)
+	return &testClient{conn: conn, reader: reader}, response
+}
+
+func (client *testClient) read(t *testing.T) []byte {
+	require.NoError(t, client.conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	var header [2]byte
+	_, err := io.ReadFull(client.reader, header[:])
+	require.NoError(t, err)
+	length := uint64(header[1] & 0x7F)
+	switch length {
+	case 126:
+		var extended [2]byte
+		_, err = io.ReadFull(client.reader, extended[:])
+		length = uint64(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		_, err = io.ReadFull(client.reader, extended[:])
+		length = binary.BigEndian.Uint64(extended[:])
+	}
+	require.NoError(t, err)
+	payload := make([]byte, length)
+	_, err = io.ReadFull(client.reader, payload)
+	require.NoError(t, err)
+	return payload
+}
+
+func (client *testClient) sendText(t *testing.T, text string) {
+	mask := []byte{1, 2, 3, 4}
+	frame := []byte{0x81, 0x80 | byte(len(text))}
+	frame = append(frame, mask...)
+	for i := 0; i < len(text); i++ {
+		frame = append(frame, text[i]^mask[i%4])
+	}
+	_, err := client.conn.Write(frame)
+	require.NoError(t, err)
+}
+
+func waitForClients(t *testing.T, server *remote.Server, count int) {
+	for i := 0; (i < 500) && (server.Clients() != count); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	require.Equal(t, count, server.Clients())
+}
+
+func TestServerStreamsFramesAndInput(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	io := imgui.CurrentIO()
+	io.SetIniFilename("")
+	io.SetDisplaySize(imgui.Vec2{X: 320, Y: 240})
+
+	server := remote.NewServer(io)
+	server.RegisterFontAtlas(io.Fonts())
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	defer server.Close()
+
+	page, err := http.Get(httpServer.URL + "/")
+	require.NoError(t, err)
+	body, err := readBody(page)
+	require.NoError(t, err)
+	assert.Contains(t, body, "client.js", "index page should load the client")
+
+	frame := func() {
+		server.ApplyInput()
+		imgui.NewFrame()
+		imgui.Begin("remote")
+		imgui.Text("Hello, browser")
+		imgui.End()
+		imgui.Render()
+		server.Publish(imgui.RenderedDrawData())
+	}
+	// a new window is hidden in its first frame
+	frame()
+	frame()
+
+	client, response := dial(t, httpServer, httpServer.URL)
+	require.Equal(t, http.StatusSwitchingProtocols, response.StatusCode)
+	assert.Equal(t, "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=", response.Header.Get("Sec-WebSocket-Accept"))
+	waitForClients(t, server, 1)
+
+	texture := client.read(t)
+	require.Equal(t, byte('T'), texture[0], "font atlas should be sent first")
+	width, height := binary.LittleEndian.Uint32(texture[5:]), binary.LittleEndian.Uint32(texture[9:])
+	assert.Equal(t, int(13+width*height*4), len(texture))
+
+	frame()
+	keyFrame := client.read(t)
+	require.Equal(t, byte('K'), keyFrame[0], "first frame should be complete")
+	assert.Equal(t, uint32(remote.FrameVersion), binary.LittleEndian.Uint32(keyFrame[1:]))
+	assert.True(t, binary.LittleEndian.Uint32(keyFrame[33:]) > 0, "frame should have command lists")
+
+	frame()
+	delta := client.read(t)
+	require.Equal(t, byte('D'), delta[0], "next frame should be a delta")
+	assert.True(t, len(delta) < len(keyFrame)/4, "delta of an unchanged frame should be small")
+
+	client.sendText(t, `{"type":"mouse","x":100,"y":50}`)
+	client.sendText(t, `{"type":"button","button":1,"down":true,"ctrl":true}`)
+	for i := 0; (i < 500) && !imgui.IsMouseDown(1); i++ {
+		time.Sleep(time.Millisecond)
+		frame()
+	}
+	assert.Equal(t, imgui.Vec2{X: 100, Y: 50}, io.MousePosition())
+	assert.True(t, imgui.IsMouseDown(1))
+	assert.True(t, io.KeyCtrlPressed())
+
+	server.Close()
+	waitForClients(t, server, 0)
+}
+
+func TestServerRejectsCrossOriginClients(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	server := remote.NewServer(imgui.CurrentIO())
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	_, response := dial(t, httpServer, "http://example.com")
+	assert.Equal(t, http.StatusForbidden, response.StatusCode)
+	assert.Equal(t, 0, server.Clients())
+}
+
+func TestServerRejectsUntrustedHosts(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	server := remote.NewServer(imgui.CurrentIO())
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+	port := httpServer.URL[strings.LastIndex(httpServer.URL, ":"):]
+
+	rebound := "attacker.example" + port
+	_, response := dialHost(t, httpServer, rebound, "http://"+rebound)
+	assert.Equal(t, http.StatusForbidden, response.StatusCode, "same origin of a rebound host name should be rejected")
+
+	client, response := dialHost(t, httpServer, "localhost"+port, "http://localhost"+port)
+	assert.Equal(t, http.StatusSwitchingProtocols, response.StatusCode)
+	client.conn.Close()
+
+	server.AllowHosts("Build-Server.example")
+	client, response = dialHost(t, httpServer, "build-server.example"+port, "http://build-server.example"+port)
+	assert.Equal(t, http.StatusSwitchingProtocols, response.StatusCode, "allowed host should be accepted")
+	client.conn.Close()
+}
+
+func readBody(response *http.Response) (string, error) {
+	defer response.Body.Close()
+	data, err := io.ReadAll(response.Body)
+	return string(data), err
+}
@@ -0,0 +1,247 @@
+package remote
+
+import (
+	"bufio"
+	"crypto/sha1" // nolint: gosec
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// The opcodes of WebSocket frames, see RFC 6455.
+const (
+	opContinuation = 0x0
+	opText         = 0x1
+	opBinary       = 0x2
+	opClose        = 0x8
+	opPing         = 0x9
+	opPong         = 0xA
+)
+
+// maxMessageSize limits the messages that are read from clients, which only send small input events.
+const maxMessageSize = 1 << 20
+
+// websocketGUID is appended to the key of the client to compute the accept key.
+const websocketGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+var errMessageTooLarge = errors.New("websocket message too large")
+
+// websocketConn is the server side of a WebSocket connection.
+// Reading must happen from one goroutine; writing is safe from several.
+type websocketConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+
+	writeLock sync.Mutex
+}
+
+// acceptKey returns the value of the Sec-WebSocket-Accept header for the given client key.
+func acceptKey(key string) string {
+	hash := sha1.Sum([]byte(key + websocketGUID)) // nolint: gosec
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// headerContains reports whether the comma separated header has the token, ignoring case.
+func headerContains(header http.Header, name, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, field := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(field), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// sameOrigin reports whether the request comes from a page of the server itself.
+// Browsers send the Origin header with WebSocket requests; without this check, any web page
+// could connect to a server on localhost and inject input.
+func sameOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	parsed, err := url.Parse(origin)
+	return (err == nil) && strings.EqualFold(parsed.Host, r.Host)
+}
+
+// hostName returns the host of the Host header of a request, without port and brackets, in lower case.
+func hostName(host string) string {
+	if name, _, err := net.SplitHostPort(host); err == nil {
+		host = name
+	}
+	return strings.ToLower(strings.TrimSuffix(strings.Trim(host, "[]"), "."))
+}
+
+// loopbackHost reports whether the host name is localhost, or a loopback address.
+// A web page of another site can make its own host name resolve to a loopback address,
+// and so pass the origin check (DNS rebinding). Its requests still carry its own host name.
+func loopbackHost(name string) bool {
+	if (name == "localhost") || strings.HasSuffix(name, ".localhost") {
+		return true
+	}
+	ip := net.ParseIP(name)
+	return (ip != nil) && ip.IsLoopback()
+}
+
+// upgradeWebSocket performs the opening handshake. On failure, it writes an error response.
+func upgradeWebSocket(w http.ResponseWriter, r *http.Request) (*websocketConn, error) {
+	key := r.Header.Get("Sec-WebSocket-Key")
+	switch {
+	case r.Method != http.MethodGet:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return nil, errors.New("websocket handshake: method not GET")
+	case !headerContains(r.Header, "Connection", "upgrade") || !headerContains(r.Header, "Upgrade", "websocket"):
+		http.Error(w, "websocket upgrade expected", http.StatusBadRequest)
+		return nil, errors.New("websocket handshake: not an upgrade request")
+	case r.Header.Get("Sec-WebSocket-Version") != "13":
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "unsupported websocket version", http.StatusUpgradeRequired)
+		return nil, errors.New("websocket handshake: unsupported version")
+	case key == "":
+		http.Error(w, "missing websocket key", http.StatusBadRequest)
+		return nil, errors.New("websocket handshake: missing key")
+	case !sameOrigin(r):
+		http.Error(w, "cross origin request", http.StatusForbidden)
+		return nil, errors.New("websocket handshake: cross origin request")
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "connection can't be upgraded", http.StatusInternalServerError)
+		return nil, errors.New("websocket handshake: response writer is not a hijacker")
+	}
+	conn, buffered, err := hijacker.Hijack()
+	if err != nil {
+		return nil, fmt.Errorf("websocket handshake: %w", err)
+	}
+	response := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + acceptKey(key) + "\r\n\r\n"
+	if _, err := conn.Write([]byte(response)); err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("websocket handshake: %w", err)
+	}
+	return &websocketConn{conn: conn, reader: buffered.Reader}, nil
+}
+
+// writeMessage writes a complete, unfragmented message.
+func (ws *websocketConn) writeMessage(opcode byte, payload []byte) error {
+	var header [10]byte
+	header[0] = 0x80 | opcode
+	size := 2
+	switch length := len(payload); {
+	case length < 126:
+		header[1] = byte(length)
+	case length <= 0xFFFF:
+		header[1] = 126
+		binary.BigEndian.PutUint16(header[2:], uint16(length))
+		size = 4
+	default:
+		header[1] = 127
+		binary.BigEndian.PutUint64(header[2:], uint64(length))
+		size = 10
+	}
+
+	ws.writeLock.Lock()
+	defer ws.writeLock.Unlock()
+	if _, err := ws.conn.Write(header[:size]); err != nil {
+		return err
+	}
+	_, err := ws.conn.Write(payload)
+	return err
+}
+
+// readMessage returns the next data message, reassembling fragments. Pings are answered while reading.
+// A close frame is answered and reported as io.EOF.
+func (ws *websocketConn) readMessage() (opcode byte, payload []byte, err error) {
+	for {
+		final, frameOpcode, data, err := ws.readFrame(maxMessageSize - len(payload))
+		if err != nil {
+			return 0, nil, err
+		}
+		switch frameOpcode {
+		case opPing:
+			if err := ws.writeMessage(opPong, data); err != nil {
+				return 0, nil, err
+			}
+			continue
+		case opPong:
+			continue
+		case opClose:
+			_ = ws.writeMessage(opClose, nil)
+			return 0, nil, io.EOF
+		case opContinuation:
+			if opcode == 0 {
+				return 0, nil, errors.New("websocket: unexpected continuation frame")
+			}
+		default:
+			if opcode != 0 {
+				return 0, nil, errors.New("websocket: expected continuation frame")
+			}
+			opcode = frameOpcode
+		}
+		payload = append(payload, data...)
+		if final {
+			return opcode, payload, nil
+		}
+	}
+}
+
+// readFrame reads a single frame, which must be masked as it comes from a client.
+func (ws *websocketConn) readFrame(limit int) (final bool, opcode byte, payload []byte, err error) {
+	var header [2]byte
+	if _, err := io.ReadFull(ws.reader, header[:]); err != nil {
+		return false, 0, nil, err
+	}
+	final = header[0]&0x80 != 0
+	opcode = header[0] & 0x0F
+	if header[1]&0x80 == 0 {
+		return false, 0, nil, errors.New("websocket: unmasked client frame")
+	}
+
+	length := uint64(header[1] & 0x7F)
+	switch length {
+	case 126:
+		var extended [2]byte
+		if _, err := io.ReadFull(ws.reader, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = uint64(binary.BigEndian.Uint16(extended[:]))
+	case 127:
+		var extended [8]byte
+		if _, err := io.ReadFull(ws.reader, extended[:]); err != nil {
+			return false, 0, nil, err
+		}
+		length = binary.BigEndian.Uint64(extended[:])
+	}
+	if length > uint64(limit) {
+		return false, 0, nil, errMessageTooLarge
+	}
+
+	var mask [4]byte
+	if _, err := io.ReadFull(ws.reader, mask[:]); err != nil {
+		return false, 0, nil, err
+	}
+	payload = make([]byte, length)
+	if _, err := io.ReadFull(ws.reader, payload); err != nil {
+		return false, 0, nil, err
+	}
+	for i := range payload {
+		payload[i] ^= mask[i%4]
+	}
+	return final, opcode, payload, nil
+}
+
+// Close closes the underlying connection.
+func (ws *websocketConn) Close() error {
+	return ws.conn.Close()
+}
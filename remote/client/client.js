@@ -0,0 +1,338 @@
+// Client of the remote package: renders the frames of the server with WebGL 2,
+// and sends the input events of the canvas back to the server.
+"use strict";
+
+(function () {
+	const frameVersion = 1;
+
+	const canvas = document.getElementById("screen");
+	const status = document.getElementById("status");
+	const gl = canvas.getContext("webgl2", { alpha: false, antialias: false });
+
+	const vertexShader = `#version 300 es
+		uniform mat4 projection;
+		in vec2 position;
+		in vec2 uv;
+		in vec4 color;
+		out vec2 fragUV;
+		out vec4 fragColor;
+		void main() {
+			fragUV = uv;
+			fragColor = color;
+			gl_Position = projection * vec4(position, 0, 1);
+		}`;
+	const fragmentShader = `#version 300 es
+		precision mediump float;
+		uniform sampler2D sampler;
+		in vec2 fragUV;
+		in vec4 fragColor;
+		out vec4 outColor;
+		void main() {
+			outColor = fragColor * texture(sampler, fragUV);
+		}`;
+
+	function compile(type, source) {
+		const shader = gl.createShader(type);
+		gl.shaderSource(shader, source);
+		gl.compileShader(shader);
+		if (!gl.getShaderParameter(shader, gl.COMPILE_STATUS)) {
+			throw new Error(gl.getShaderInfoLog(shader));
+		}
+		return shader;
+	}
+
+	const program = gl.createProgram();
+	gl.attachShader(program, compile(gl.VERTEX_SHADER, vertexShader));
+	gl.attachShader(program, compile(gl.FRAGMENT_SHADER, fragmentShader));
+	gl.linkProgram(program);
+	const projectionLocation = gl.getUniformLocation(program, "projection");
+	const positionLocation = gl.getAttribLocation(program, "position");
+	const uvLocation = gl.getAttribLocation(program, "uv");
+	const colorLocation = gl.getAttribLocation(program, "color");
+
+	const vertexBuffer = gl.createBuffer();
+	const indexBuffer = gl.createBuffer();
+	const vertexArray = gl.createVertexArray();
+	gl.bindVertexArray(vertexArray);
+	gl.bindBuffer(gl.ARRAY_BUFFER, vertexBuffer);
+	gl.enableVertexAttribArray(positionLocation);
+	gl.enableVertexAttribArray(uvLocation);
+	gl.enableVertexAttribArray(colorLocation);
+	gl.vertexAttribPointer(positionLocation, 2, gl.FLOAT, false, 20, 0);
+	gl.vertexAttribPointer(uvLocation, 2, gl.FLOAT, false, 20, 8);
+	gl.vertexAttribPointer(colorLocation, 4, gl.UNSIGNED_BYTE, true, 20, 16);
+
+	// textures by number; number 0, and unknown numbers, use a white texture.
+	const textures = new Map();
+	const white = gl.createTexture();
+	gl.bindTexture(gl.TEXTURE_2D, white);
+	gl.texImage2D(gl.TEXTURE_2D, 0, gl.RGBA, 1, 1, 0, gl.RGBA, gl.UNSIGNED_BYTE, new Uint8Array([255, 255, 255, 255]));
+
+	function updateTexture(view) {
+		const number = view.getUint32(1, true);
+		const width = view.getUint32(5, true);
+		const height = view.getUint32(9, true);
+		let texture = textures.get(number);
+		if (!texture) {
+			texture = gl.createTexture();
+			textures.set(number, texture);
+		}
+		gl.bindTexture(gl.TEXTURE_2D, texture);
+		gl.texParameteri(gl.TEXTURE_2D, gl.TEXTURE_MIN_FILTER, gl.LINEAR);
+		gl.texParameteri(gl.TEXTURE_2D, gl.TEXTURE_MAG_FILTER, gl.LINEAR);
+		gl.texParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_S, gl.CLAMP_TO_EDGE);
+		gl.texParameteri(gl.TEXTURE_2D, gl.TEXTURE_WRAP_T, gl.CLAMP_TO_EDGE);
+		const pixels = new Uint8Array(view.buffer, view.byteOffset + 13, width * height * 4);
+		gl.texImage2D(gl.TEXTURE_2D, 0, gl.RGBA, width, height, 0, gl.RGBA, gl.UNSIGNED_BYTE, pixels);
+	}
+
+	// readUvarint returns the value and the position after it.
+	function readUvarint(bytes, pos) {
+		let value = 0;
+		let scale = 1;
+		for (;;) {
+			const b = bytes[pos++];
+			value += (b & 0x7f) * scale;
+			if (b < 0x80) {
+				return [value, pos];
+			}
+			scale *= 128;
+		}
+	}
+
+	// applyDelta reconstructs the next frame from the previous frame, see appendDelta() of the server.
+	function applyDelta(previous, delta) {
+		let [length, pos] = readUvarint(delta, 0);
+		const next = new Uint8Array(length);
+		let offset = 0;
+		while (offset < length) {
+			let op;
+			[op, pos] = readUvarint(delta, pos);
+			const count = Math.floor(op / 2);
+			if (op % 2 === 1) {
+				next.set(delta.subarray(pos, pos + count), offset);
+				pos += count;
+			} else {
+				next.set(previous.subarray(offset, offset + count), offset);
+			}
+			offset += count;
+		}
+		return next;
+	}
+
+	function render(frame) {
+		const view = new DataView(frame.buffer, frame.byteOffset, frame.byteLength);
+		if (view.getUint32(0, true) !== frameVersion) {
+			status.textContent = "unsupported frame version";
+			return;
+		}
+		const indexSize = view.getUint32(4, true);
+		const posX = view.getFloat32(8, true);
+		const posY = view.getFloat32(12, true);
+		const width = view.getFloat32(16, true);
+		const height = view.getFloat32(20, true);
+		const scaleX = view.getFloat32(24, true);
+		const scaleY = view.getFloat32(28, true);
+		const listCount = view.getUint32(32, true);
+		let pos = 36;
+
+		const pixelWidth = Math.round(width * scaleX);
+		const pixelHeight = Math.round(height * scaleY);
+		if ((canvas.width !== pixelWidth) || (canvas.height !== pixelHeight)) {
+			canvas.width = pixelWidth;
+			canvas.height = pixelHeight;
+			canvas.style.width = (pixelWidth / window.devicePixelRatio) + "px";
+			canvas.style.height = (pixelHeight / window.devicePixelRatio) + "px";
+		}
+		display = { posX, posY, width, height };
+
+		gl.viewport(0, 0, pixelWidth, pixelHeight);
+		gl.disable(gl.SCISSOR_TEST);
+		gl.clearColor(0, 0, 0, 1);
+		gl.clear(gl.COLOR_BUFFER_BIT);
+		gl.enable(gl.BLEND);
+		gl.blendEquation(gl.FUNC_ADD);
+		gl.blendFuncSeparate(gl.SRC_ALPHA, gl.ONE_MINUS_SRC_ALPHA, gl.ONE, gl.ONE_MINUS_SRC_ALPHA);
+		gl.disable(gl.CULL_FACE);
+		gl.disable(gl.DEPTH_TEST);
+		gl.enable(gl.SCISSOR_TEST);
+		gl.useProgram(program);
+		const left = posX;
+		const right = posX + width;
+		const top = posY;
+		const bottom = posY + height;
+		gl.uniformMatrix4fv(projectionLocation, false, [
+			2 / (right - left), 0, 0, 0,
+			0, 2 / (top - bottom), 0, 0,
+			0, 0, -1, 0,
+			(right + left) / (left - right), (top + bottom) / (bottom - top), 0, 1,
+		]);
+		gl.bindVertexArray(vertexArray);
+		const indexType = (indexSize === 2) ? gl.UNSIGNED_SHORT : gl.UNSIGNED_INT;
+
+		for (let list = 0; list < listCount; list++) {
+			const vertexCount = view.getUint32(pos, true);
+			const indexCount = view.getUint32(pos + 4, true);
+			const commandCount = view.getUint32(pos + 8, true);
+			pos += 12;
+			gl.bindBuffer(gl.ARRAY_BUFFER, vertexBuffer);
+			gl.bufferData(gl.ARRAY_BUFFER, frame.subarray(pos, pos + vertexCount * 20), gl.STREAM_DRAW);
+			pos += vertexCount * 20;
+			const indexBytes = indexCount * indexSize;
+			gl.bindBuffer(gl.ELEMENT_ARRAY_BUFFER, indexBuffer);
+			gl.bufferData(gl.ELEMENT_ARRAY_BUFFER, frame.subarray(pos, pos + indexBytes), gl.STREAM_DRAW);
+			pos += (indexBytes + 3) & ~3;
+
+			for (let i = 0; i < commandCount; i++) {
+				const elementCount = view.getUint32(pos, true);
+				const indexOffset = view.getUint32(pos + 4, true);
+				const vertexOffset = view.getUint32(pos + 8, true);
+				const texture = view.getUint32(pos + 12, true);
+				const clipMinX = (view.getFloat32(pos + 16, true) - posX) * scaleX;
+				const clipMinY = (view.getFloat32(pos + 20, true) - posY) * scaleY;
+				const clipMaxX = (view.getFloat32(pos + 24, true) - posX) * scaleX;
+				const clipMaxY = (view.getFloat32(pos + 28, true) - posY) * scaleY;
+				pos += 32;
+				if ((clipMaxX <= clipMinX) || (clipMaxY <= clipMinY)) {
+					continue;
+				}
+				gl.scissor(clipMinX, pixelHeight - clipMaxY, clipMaxX - clipMinX, clipMaxY - clipMinY);
+				gl.bindTexture(gl.TEXTURE_2D, textures.get(texture) || white);
+				if (vertexOffset !== 0) {
+					// WebGL has no base vertex; rebind the attributes at the offset.
+					gl.vertexAttribPointer(positionLocation, 2, gl.FLOAT, false, 20, vertexOffset * 20);
+					gl.vertexAttribPointer(uvLocation, 2, gl.FLOAT, false, 20, vertexOffset * 20 + 8);
+					gl.vertexAttribPointer(colorLocation, 4, gl.UNSIGNED_BYTE, true, 20, vertexOffset * 20 + 16);
+				}
+				gl.drawElements(gl.TRIANGLES, elementCount, indexType, indexOffset * indexSize);
+				if (vertexOffset !== 0) {
+					gl.vertexAttribPointer(positionLocation, 2, gl.FLOAT, false, 20, 0);
+					gl.vertexAttribPointer(uvLocation, 2, gl.FLOAT, false, 20, 8);
+					gl.vertexAttribPointer(colorLocation, 4, gl.UNSIGNED_BYTE, true, 20, 16);
+				}
+			}
+		}
+	}
+
+	let socket = null;
+	let previous = null;
+	let display = { posX: 0, posY: 0, width: 0, height: 0 };
+	let pending = null;
+
+	function draw() {
+		if (pending) {
+			render(pending);
+			pending = null;
+		}
+	}
+
+	function receive(event) {
+		const bytes = new Uint8Array(event.data);
+		const view = new DataView(event.data);
+		switch (String.fromCharCode(bytes[0])) {
+		case "T":
+			updateTexture(view);
+			return;
+		case "K":
+			// copied, for the frame to start at an aligned offset.
+			previous = bytes.slice(1);
+			break;
+		case "D":
+			if (!previous) {
+				return;
+			}
+			previous = applyDelta(previous, bytes.subarray(1));
+			break;
+		default:
+			return;
+		}
+		if (!pending) {
+			requestAnimationFrame(draw);
+		}
+		pending = previous;
+	}
+
+	function connect() {
+		const url = new URL("ws", window.location.href);
+		url.protocol = (url.protocol === "https:") ? "wss:" : "ws:";
+		socket = new WebSocket(url);
+		socket.binaryType = "arraybuffer";
+		socket.onopen = function () {
+			status.textContent = "";
+		};
+		socket.onmessage = receive;
+		socket.onclose = function () {
+			status.textContent = "disconnected";
+			socket = null;
+			previous = null;
+			setTimeout(connect, 1000);
+		};
+	}
+
+	function send(event, modifiers) {
+		if (!socket || (socket.readyState !== WebSocket.OPEN)) {
+			return;
+		}
+		event.ctrl = modifiers.ctrlKey;
+		event.shift = modifiers.shiftKey;
+		event.alt = modifiers.altKey;
+		event.super = modifiers.metaKey;
+		socket.send(JSON.stringify(event));
+	}
+
+	// position converts the position of a mouse event to display coordinates.
+	function position(event) {
+		const rect = canvas.getBoundingClientRect();
+		return {
+			x: display.posX + (event.clientX - rect.left) * display.width / rect.width,
+			y: display.posY + (event.clientY - rect.top) * display.height / rect.height,
+		};
+	}
+
+	// buttons maps the DOM buttons (left, middle, right) to those of ImGui (left, right, middle).
+	const buttons = [0, 2, 1, 3, 4];
+
+	canvas.addEventListener("mousemove", function (event) {
+		const pos = position(event);
+		send({ type: "mouse", x: pos.x, y: pos.y }, event);
+	});
+	canvas.addEventListener("mousedown", function (event) {
+		canvas.focus();
+		const pos = position(event);
+		send({ type: "mouse", x: pos.x, y: pos.y }, event);
+		send({ type: "button", button: buttons[event.button], down: true }, event);
+		event.preventDefault();
+	});
+	window.addEventListener("mouseup", function (event) {
+		send({ type: "button", button: buttons[event.button], down: false }, event);
+	});
+	canvas.addEventListener("contextmenu", function (event) {
+		event.preventDefault();
+	});
+	canvas.addEventListener("wheel", function (event) {
+		const scale = (event.deltaMode === WheelEvent.DOM_DELTA_PIXEL) ? 1 / 100 : 1 / 3;
+		send({ type: "wheel", x: -event.deltaX * scale, y: -event.deltaY * scale }, event);
+		event.preventDefault();
+	}, { passive: false });
+	canvas.addEventListener("keydown", function (event) {
+		send({ type: "key", code: event.code, down: true }, event);
+		if ((event.key.length === 1) && !event.ctrlKey && !event.metaKey) {
+			send({ type: "text", text: event.key }, event);
+		}
+		if ((event.key.length === 1) || (event.key === "Tab") || (event.key === "Backspace")) {
+			event.preventDefault();
+		}
+	});
+	canvas.addEventListener("keyup", function (event) {
+		send({ type: "key", code: event.code, down: false }, event);
+	});
+	canvas.addEventListener("focus", function (event) {
+		send({ type: "focus", focused: true }, event);
+	});
+	canvas.addEventListener("blur", function (event) {
+		send({ type: "focus", focused: false }, event);
+	});
+
+	connect();
+	canvas.focus();
+})();
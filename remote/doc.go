@@ -0,0 +1,31 @@
+// Package remote streams the frames of an ImGui context to web browsers, and feeds their input back into imgui.IO.
+//
+// The Server is an http.Handler. It serves a small client that renders the frames with WebGL 2,
+// and a WebSocket endpoint. Every published frame carries the vertices, indices and draw commands
+// of all command lists, with the clip rectangles and texture numbers of the commands. Textures,
+// such as the font atlas, are sent once, when they are registered or when a client connects.
+// After the first complete frame, a client only receives the bytes that changed since the previous
+// frame, so a static user interface costs little more than a few bytes per frame.
+//
+// The input events of the browser are queued, and added to the IO by ApplyInput().
+//
+// A typical use is inspecting headless instances, for example on a build server:
+//
+//	server := remote.NewServer(imgui.CurrentIO())
+//	server.RegisterFontAtlas(imgui.CurrentIO().Fonts())
+//	go http.ListenAndServe("localhost:8080", server)
+//
+//	for {
+//		server.ApplyInput()
+//		imgui.NewFrame()
+//		// ...
+//		imgui.Render()
+//		server.Publish(imgui.RenderedDrawData())
+//	}
+//
+// The server accepts WebSocket connections only from pages it served itself, so that other web pages
+// can't inject input. It only serves requests for localhost, the loopback addresses and the host names
+// allowed by AllowHosts(), so that other web pages can't pose as the server through DNS rebinding.
+// It does not authenticate clients: listen on localhost, and use an SSH tunnel or an authenticating
+// proxy to reach it from another machine.
+package remote
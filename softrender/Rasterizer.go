@@ -0,0 +1,173 @@
+package softrender
+
+import (
+	"image"
+	"math"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// vertex is a decoded entry of a vertex buffer, with its position in pixel space.
+type vertex struct {
+	x, y float32
+	u, v float32
+	col  imgui.PackedColor
+}
+
+// rgba is a non-premultiplied color with channels in the range [0,1].
+type rgba struct {
+	r, g, b, a float32
+}
+
+func unpack(col imgui.PackedColor) rgba {
+	const scale = 1.0 / 255.0
+	return rgba{
+		r: float32(uint8(col)) * scale,
+		g: float32(uint8(col>>8)) * scale,
+		b: float32(uint8(col>>16)) * scale,
+		a: float32(uint8(col>>24)) * scale,
+	}
+}
+
+// rasterizer fills triangles into the target image, limited to the clip rectangle.
+//
+// Pixels are sampled at their centers. Pixels exactly on an edge are only filled if the edge is
+// a top or left edge, so that triangles sharing an edge never blend the same pixel twice.
+// Texture coordinates are sampled with nearest-neighbour filtering and the result is blended
+// with the common (source alpha, one minus source alpha) blend function.
+type rasterizer struct {
+	target  *image.RGBA
+	clip    image.Rectangle
+	texture *image.NRGBA
+}
+
+// edge is the edge function of the line a->b, evaluated at point (x, y).
+func edge(a, b vertex, x, y float32) float32 {
+	return (b.x-a.x)*(y-a.y) - (b.y-a.y)*(x-a.x)
+}
+
+// isTopLeft returns true if the edge a->b is a top or a left edge, for a triangle with a positive area.
+func isTopLeft(a, b vertex) bool {
+	dx := b.x - a.x
+	dy := b.y - a.y
+	return ((dy == 0) && (dx > 0)) || (dy < 0)
+}
+
+func covers(w float32, topLeft bool) bool {
+	return (w > 0) || ((w == 0) && topLeft)
+}
+
+func (raster *rasterizer) triangle(v0, v1, v2 vertex) {
+	area := edge(v0, v1, v2.x, v2.y)
+	if area == 0 {
+		return
+	}
+	if area < 0 {
+		v1, v2 = v2, v1
+		area = -area
+	}
+
+	bounds := image.Rect(
+		int(math.Floor(float64(min3(v0.x, v1.x, v2.x)))),
+		int(math.Floor(float64(min3(v0.y, v1.y, v2.y)))),
+		int(math.Ceil(float64(max3(v0.x, v1.x, v2.x)))),
+		int(math.Ceil(float64(max3(v0.y, v1.y, v2.y))))).Intersect(raster.clip)
+	if bounds.Empty() {
+		return
+	}
+
+	topLeft0 := isTopLeft(v1, v2)
+	topLeft1 := isTopLeft(v2, v0)
+	topLeft2 := isTopLeft(v0, v1)
+
+	c0 := unpack(v0.col)
+	c1 := unpack(v1.col)
+	c2 := unpack(v2.col)
+	texW := raster.texture.Rect.Dx()
+	texH := raster.texture.Rect.Dy()
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		py := float32(y) + 0.5
+		row := raster.target.PixOffset(0, y)
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			px := float32(x) + 0.5
+			w0 := edge(v1, v2, px, py)
+			w1 := edge(v2, v0, px, py)
+			w2 := edge(v0, v1, px, py)
+			if !covers(w0, topLeft0) || !covers(w1, topLeft1) || !covers(w2, topLeft2) {
+				continue
+			}
+			b0 := w0 / area
+			b1 := w1 / area
+			b2 := w2 / area
+
+			u := b0*v0.u + b1*v1.u + b2*v2.u
+			v := b0*v0.v + b1*v1.v + b2*v2.v
+			tex := raster.sample(u, v, texW, texH)
+
+			src := rgba{
+				r: (b0*c0.r + b1*c1.r + b2*c2.r) * tex.r,
+				g: (b0*c0.g + b1*c1.g + b2*c2.g) * tex.g,
+				b: (b0*c0.b + b1*c1.b + b2*c2.b) * tex.b,
+				a: (b0*c0.a + b1*c1.a + b2*c2.a) * tex.a,
+			}
+			blend(raster.target.Pix[row+x*4:row+x*4+4], src)
+		}
+	}
+}
+
+func (raster *rasterizer) sample(u, v float32, width, height int) rgba {
+	tx := clampInt(int(u*float32(width)), 0, width-1)
+	ty := clampInt(int(v*float32(height)), 0, height-1)
+	offset := raster.texture.PixOffset(tx, ty)
+	pix := raster.texture.Pix[offset : offset+4]
+	const scale = 1.0 / 255.0
+	return rgba{
+		r: float32(pix[0]) * scale,
+		g: float32(pix[1]) * scale,
+		b: float32(pix[2]) * scale,
+		a: float32(pix[3]) * scale,
+	}
+}
+
+// blend composes the non-premultiplied source color over the premultiplied destination pixel.
+func blend(dst []byte, src rgba) {
+	if src.a <= 0 {
+		return
+	}
+	inv := 1 - src.a
+	dst[0] = toByte(src.r*src.a*255 + float32(dst[0])*inv)
+	dst[1] = toByte(src.g*src.a*255 + float32(dst[1])*inv)
+	dst[2] = toByte(src.b*src.a*255 + float32(dst[2])*inv)
+	dst[3] = toByte(src.a*255 + float32(dst[3])*inv)
+}
+
+func toByte(value float32) byte {
+	scaled := value + 0.5
+	switch {
+	case scaled <= 0:
+		return 0
+	case scaled >= 255:
+		return 255
+	default:
+		return byte(scaled)
+	}
+}
+
+func clampInt(value, low, high int) int {
+	if value < low {
+		return low
+	}
+	if value > high {
+		return high
+	}
+	return value
+}
+
+func min3(a, b, c float32) float32 {
+	return float32(math.Min(float64(a), math.Min(float64(b), float64(c))))
+}
+
+func max3(a, b, c float32) float32 {
+	return float32(math.Max(float64(a), math.Max(float64(b), float64(c))))
+}
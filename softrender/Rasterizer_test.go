@@ -0,0 +1,49 @@
+package softrender // nolint: testpackage
+
+import (
+	"image"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func whiteTexture() *image.NRGBA {
+	texture := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	copy(texture.Pix, []byte{0xFF, 0xFF, 0xFF, 0xFF})
+	return texture
+}
+
+func TestRasterizerFillsTriangleInsideClipRect(t *testing.T) {
+	target := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	raster := rasterizer{target: target, clip: image.Rect(0, 0, 4, 8), texture: whiteTexture()}
+
+	red := vertex{col: 0xFF0000FF}
+	v0, v1, v2 := red, red, red
+	v0.x, v0.y = 0, 0
+	v1.x, v1.y = 8, 0
+	v2.x, v2.y = 0, 8
+	raster.triangle(v0, v1, v2)
+
+	assert.Equal(t, []byte{0xFF, 0x00, 0x00, 0xFF}, target.Pix[target.PixOffset(1, 1):target.PixOffset(1, 1)+4], "inside pixel should be filled")
+	assert.Equal(t, []byte{0x00, 0x00, 0x00, 0x00}, target.Pix[target.PixOffset(5, 1):target.PixOffset(5, 1)+4], "clipped pixel should be untouched")
+	assert.Equal(t, []byte{0x00, 0x00, 0x00, 0x00}, target.Pix[target.PixOffset(3, 6):target.PixOffset(3, 6)+4], "pixel outside triangle should be untouched")
+}
+
+func TestRasterizerBlendsSharedEdgesOnlyOnce(t *testing.T) {
+	target := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	raster := rasterizer{target: target, clip: target.Bounds(), texture: whiteTexture()}
+
+	halfWhite := vertex{col: 0x80FFFFFF}
+	topLeft, topRight, bottomRight, bottomLeft := halfWhite, halfWhite, halfWhite, halfWhite
+	topRight.x = 4
+	bottomRight.x, bottomRight.y = 4, 4
+	bottomLeft.y = 4
+	raster.triangle(topLeft, topRight, bottomRight)
+	raster.triangle(topLeft, bottomRight, bottomLeft)
+
+	for y := 0; y < 4; y++ {
+		for x := 0; x < 4; x++ {
+			assert.Equal(t, byte(0x80), target.Pix[target.PixOffset(x, y)+3], "alpha mismatch at %d,%d", x, y)
+		}
+	}
+}
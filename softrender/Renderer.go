@@ -0,0 +1,146 @@
+package softrender
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"math"
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// Renderer rasterizes the draw data of an ImGui frame into an image.
+//
+// A Renderer is not safe for concurrent use.
+type Renderer struct {
+	textures   map[imgui.TextureID]*image.NRGBA
+	clearColor color.Color
+
+	vertices []vertex
+}
+
+// NewRenderer returns a renderer without any registered textures.
+// The clear color is transparent black.
+func NewRenderer() *Renderer {
+	renderer := &Renderer{
+		textures:   make(map[imgui.TextureID]*image.NRGBA),
+		clearColor: color.Transparent,
+	}
+	return renderer
+}
+
+// SetClearColor sets the color that Render() fills a new image with before drawing.
+func (renderer *Renderer) SetClearColor(clr color.Color) {
+	renderer.clearColor = clr
+}
+
+// RegisterTexture makes the image available to draw commands that refer to the given texture ID.
+// The image is copied, later changes to it are not seen by the renderer.
+// Registering an image for an ID that is already in use replaces the previous image.
+func (renderer *Renderer) RegisterTexture(id imgui.TextureID, img image.Image) {
+	bounds := img.Bounds()
+	texture := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(texture, texture.Bounds(), img, bounds.Min, draw.Src)
+	renderer.textures[id] = texture
+}
+
+// UnregisterTexture removes the image for the given texture ID.
+// Draw commands that refer to an unregistered texture are not rendered.
+func (renderer *Renderer) UnregisterTexture(id imgui.TextureID) {
+	delete(renderer.textures, id)
+}
+
+// RegisterFontAtlas registers the RGBA32 texture data of the font atlas, using the texture ID of the atlas.
+// The atlas must be registered again if its texture ID or its fonts change.
+func (renderer *Renderer) RegisterFontAtlas(atlas imgui.FontAtlas) {
+	data := atlas.TextureDataRGBA32()
+	texture := image.NewNRGBA(image.Rect(0, 0, data.Width, data.Height))
+	if data.Pixels != nil {
+		copy(texture.Pix, ptrToByteSlice(data.Pixels, len(texture.Pix)))
+	}
+	renderer.textures[atlas.TextureID()] = texture
+}
+
+// Render creates a new image, filled with the clear color, and renders the draw data into it.
+// The size of the image is the display size of the draw data, multiplied by the frame buffer scale.
+func (renderer *Renderer) Render(data imgui.DrawData) *image.RGBA {
+	size := data.DisplaySize()
+	scale := data.FrameBufferScale()
+	target := image.NewRGBA(image.Rect(0, 0, int(size.X*scale.X), int(size.Y*scale.Y)))
+	draw.Draw(target, target.Bounds(), image.NewUniform(renderer.clearColor), image.Point{}, draw.Src)
+	renderer.RenderInto(target, data)
+	return target
+}
+
+// RenderInto renders the draw data on top of the current content of the target image.
+// Draw data that is not valid is ignored.
+//
+// Draw commands with a user callback are skipped, as there is no render state that a callback
+// could act upon.
+func (renderer *Renderer) RenderInto(target *image.RGBA, data imgui.DrawData) {
+	if !data.Valid() {
+		return
+	}
+	pos := data.DisplayPos()
+	scale := data.FrameBufferScale()
+
+	for _, list := range data.CommandLists() {
+		renderer.readVertices(list, pos, scale)
+		listIndices := list.Indices()
+
+		for _, cmd := range list.Commands() {
+			if cmd.HasUserCallback() {
+				continue
+			}
+			texture, registered := renderer.textures[cmd.TextureID()]
+			if !registered {
+				continue
+			}
+			clip := clipBounds(cmd.ClipRect(), pos, scale).Intersect(target.Bounds())
+			if clip.Empty() {
+				continue
+			}
+
+			raster := rasterizer{target: target, clip: clip, texture: texture}
+			vertices := renderer.vertices[cmd.VertexOffset():]
+			indices := listIndices[cmd.IndexOffset() : cmd.IndexOffset()+cmd.ElementCount()]
+			for i := 0; i+2 < len(indices); i += 3 {
+				raster.triangle(vertices[indices[i]], vertices[indices[i+1]], vertices[indices[i+2]])
+			}
+		}
+	}
+}
+
+// clipBounds returns the pixels that are at least partially covered by the clip rectangle of a command.
+func clipBounds(clipRect imgui.Vec4, displayPos imgui.Vec2, scale imgui.Vec2) image.Rectangle {
+	return image.Rectangle{
+		Min: image.Pt(
+			int(math.Floor(float64((clipRect.X-displayPos.X)*scale.X))),
+			int(math.Floor(float64((clipRect.Y-displayPos.Y)*scale.Y)))),
+		Max: image.Pt(
+			int(math.Ceil(float64((clipRect.Z-displayPos.X)*scale.X))),
+			int(math.Ceil(float64((clipRect.W-displayPos.Y)*scale.Y)))),
+	}
+}
+
+// readVertices reads the vertices of the list, transforming the positions into pixel space.
+func (renderer *Renderer) readVertices(list imgui.DrawList, displayPos imgui.Vec2, scale imgui.Vec2) {
+	renderer.vertices = renderer.vertices[:0]
+	for _, v := range list.Vertices() {
+		renderer.vertices = append(renderer.vertices, vertex{
+			x:   (v.Pos.X - displayPos.X) * scale.X,
+			y:   (v.Pos.Y - displayPos.Y) * scale.Y,
+			u:   v.UV.X,
+			v:   v.UV.Y,
+			col: v.Col,
+		})
+	}
+}
+
+// unrealisticLargePointer is used to cast a native buffer to a byte slice.
+const unrealisticLargePointer = 1 << 30
+
+func ptrToByteSlice(ptr unsafe.Pointer, size int) []byte {
+	return (*[unrealisticLargePointer]byte)(ptr)[:size:size]
+}
@@ -0,0 +1,88 @@
+package softrender_test
+
+import (
+	"image/color"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+	"github.com/jetsetilly/imgui-go/v5/softrender"
+)
+
+func TestRenderDrawsWindowBackground(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	io := imgui.CurrentIO()
+	io.SetDisplaySize(imgui.Vec2{X: 320, Y: 240})
+	io.SetIniFilename("")
+
+	renderer := softrender.NewRenderer()
+	renderer.SetClearColor(color.Black)
+	renderer.RegisterFontAtlas(io.Fonts())
+
+	for i := 0; i < 2; i++ {
+		imgui.NewFrame()
+		imgui.SetNextWindowPos(imgui.Vec2{X: 10, Y: 10})
+		imgui.SetNextWindowSize(imgui.Vec2{X: 200, Y: 100})
+		imgui.Begin("softrender")
+		imgui.Text("Hello, world")
+		imgui.End()
+		imgui.Render()
+	}
+
+	img := renderer.Render(imgui.RenderedDrawData())
+	require.Equal(t, 320, img.Bounds().Dx())
+	require.Equal(t, 240, img.Bounds().Dy())
+
+	assert.Equal(t, color.RGBA{A: 0xFF}, img.RGBAAt(300, 200), "pixel outside window should have clear color")
+	assert.NotEqual(t, color.RGBA{A: 0xFF}, img.RGBAAt(100, 80), "pixel inside window should be drawn")
+}
+
+func TestRenderSkipsUnregisteredTextures(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	io := imgui.CurrentIO()
+	io.SetDisplaySize(imgui.Vec2{X: 64, Y: 64})
+	io.SetIniFilename("")
+	io.Fonts().TextureDataRGBA32()
+
+	imgui.NewFrame()
+	imgui.Render()
+
+	renderer := softrender.NewRenderer()
+	renderer.SetClearColor(color.White)
+	img := renderer.Render(imgui.RenderedDrawData())
+
+	assert.Equal(t, color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}, img.RGBAAt(32, 32))
+}
+
+func TestRenderIncludesPartiallyClippedPixels(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	io := imgui.CurrentIO()
+	io.SetDisplaySize(imgui.Vec2{X: 64, Y: 64})
+	io.SetIniFilename("")
+
+	renderer := softrender.NewRenderer()
+	renderer.SetClearColor(color.Black)
+	renderer.RegisterFontAtlas(io.Fonts())
+
+	imgui.NewFrame()
+	list := imgui.ForegroundDrawList()
+	list.PushClipRect(imgui.Vec2{X: 10.5, Y: 10.5}, imgui.Vec2{X: 20.5, Y: 20.5})
+	list.AddRectFilled(imgui.Vec2{}, imgui.Vec2{X: 64, Y: 64}, imgui.Packed(color.White))
+	list.PopClipRect()
+	imgui.Render()
+
+	img := renderer.Render(imgui.RenderedDrawData())
+	white := color.RGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}
+	assert.Equal(t, white, img.RGBAAt(10, 10), "pixel partially inside the clip rectangle should be drawn")
+	assert.Equal(t, white, img.RGBAAt(20, 20), "pixel partially inside the clip rectangle should be drawn")
+	assert.Equal(t, color.RGBA{A: 0xFF}, img.RGBAAt(9, 9), "pixel outside the clip rectangle should have clear color")
+	assert.Equal(t, color.RGBA{A: 0xFF}, img.RGBAAt(21, 21), "pixel outside the clip rectangle should have clear color")
+}
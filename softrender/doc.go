@@ -0,0 +1,19 @@
+// Package softrender contains a pure-Go rasterizer for imgui.DrawData.
+//
+// The renderer does not require a GPU. It walks the command lists of a rendered frame,
+// honouring the clip rectangle, texture and vertex/index offsets of every draw command,
+// and blends the resulting triangles into an *image.RGBA.
+//
+// Textures are sampled from images registered with the renderer. The font atlas is
+// registered with RegisterFontAtlas(), all other textures with RegisterTexture().
+//
+// A typical use is rendering frames on machines without a display:
+//
+//	renderer := softrender.NewRenderer()
+//	renderer.RegisterFontAtlas(imgui.CurrentIO().Fonts())
+//
+//	imgui.NewFrame()
+//	// ...
+//	imgui.Render()
+//	img := renderer.Render(imgui.RenderedDrawData())
+package softrender
@@ -0,0 +1,344 @@
+package termrender
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// Input decodes the bytes read from a terminal in raw mode, and adds the corresponding events to imgui.IO.
+//
+// Escape sequences may be split across writes; an incomplete sequence at the end of a write is kept
+// until the next write. As a single escape byte can't be told apart from the start of a sequence,
+// call Flush() when no further input arrived for a moment, to report it as the Escape key.
+type Input struct {
+	target   imgui.IO
+	cellSize imgui.Vec2
+	pending  []byte
+	mods     imgui.ImguiKey
+}
+
+// NewInput returns an adapter for the given IO. Mouse positions are reported at the center of their cell,
+// for cells of the given size.
+func NewInput(target imgui.IO, cellSize imgui.Vec2) *Input {
+	return &Input{target: target, cellSize: cellSize}
+}
+
+// Write decodes the bytes and adds the events. It always consumes all bytes and never fails.
+func (input *Input) Write(p []byte) (int, error) {
+	input.pending = append(input.pending, p...)
+	consumed := input.decode(input.pending)
+	input.pending = append(input.pending[:0], input.pending[consumed:]...)
+	return len(p), nil
+}
+
+// Flush reports an incomplete sequence: a single escape byte is the Escape key, anything else is dropped.
+func (input *Input) Flush() {
+	if (len(input.pending) == 1) && (input.pending[0] == escape) {
+		input.pressKey(imgui.KeyEscape, imgui.KeyModNone)
+	}
+	input.pending = input.pending[:0]
+}
+
+const escape = 0x1b
+
+// decode adds the events of all complete sequences, and returns the number of bytes they use.
+func (input *Input) decode(data []byte) int {
+	var text strings.Builder
+	flushText := func() {
+		if text.Len() > 0 {
+			input.target.AddInputCharacters(text.String())
+			text.Reset()
+		}
+	}
+	defer flushText()
+
+	pos := 0
+	for pos < len(data) {
+		b := data[pos]
+		if (b >= 0x20) && (b != 0x7F) {
+			if !utf8.FullRune(data[pos:]) {
+				return pos
+			}
+			r, size := utf8.DecodeRune(data[pos:])
+			if r != utf8.RuneError {
+				text.WriteRune(r)
+			}
+			pos += size
+			continue
+		}
+		flushText()
+		if b != escape {
+			input.control(b, imgui.KeyModNone)
+			pos++
+			continue
+		}
+		size := input.escapeSequence(data[pos:])
+		if size == 0 {
+			return pos
+		}
+		pos += size
+	}
+	return pos
+}
+
+// control reports a control character: Enter, Tab, Backspace, or a letter with Ctrl.
+func (input *Input) control(b byte, mods imgui.ImguiKey) {
+	switch {
+	case (b == '\r') || (b == '\n'):
+		input.pressKey(imgui.KeyEnter, mods)
+	case b == '\t':
+		input.pressKey(imgui.KeyTab, mods)
+	case (b == 0x7F) || (b == 0x08):
+		input.pressKey(imgui.KeyBackspace, mods)
+	case b == 0x00:
+		input.pressKey(imgui.KeySpace, mods|imgui.KeyModCtrl)
+	case b <= 0x1A:
+		input.pressKey(imgui.KeyA+imgui.ImguiKey(b-1), mods|imgui.KeyModCtrl)
+	}
+}
+
+// escapeSequence reports the sequence at the start of data, which starts with an escape byte.
+// It returns the size of the sequence, or zero if the sequence is incomplete.
+func (input *Input) escapeSequence(data []byte) int {
+	if len(data) < 2 {
+		return 0
+	}
+	next := data[1]
+	switch {
+	case next == '[':
+		return input.csi(data)
+	case next == 'O':
+		if len(data) < 3 {
+			return 0
+		}
+		if key, known := ss3Keys[data[2]]; known {
+			input.pressKey(key, imgui.KeyModNone)
+		}
+		return 3
+	case next == escape:
+		input.pressKey(imgui.KeyEscape, imgui.KeyModNone)
+		return 1
+	case (next < 0x20) || (next == 0x7F):
+		input.control(next, imgui.KeyModAlt)
+		return 2
+	case (next >= 'a') && (next <= 'z'):
+		input.pressKey(imgui.KeyA+imgui.ImguiKey(next-'a'), imgui.KeyModAlt)
+		return 2
+	case (next >= 'A') && (next <= 'Z'):
+		input.pressKey(imgui.KeyA+imgui.ImguiKey(next-'A'), imgui.KeyModAlt|imgui.KeyModShift)
+		return 2
+	case (next >= '0') && (next <= '9'):
+		input.pressKey(imgui.Key0+imgui.ImguiKey(next-'0'), imgui.KeyModAlt)
+		return 2
+	}
+	if stroke, known := printableKeys[next]; known {
+		input.pressKey(stroke.key, stroke.mods|imgui.KeyModAlt)
+		return 2
+	}
+	input.pressKey(imgui.KeyEscape, imgui.KeyModNone)
+	return 1
+}
+
+// keyStroke is a key, pressed while the modifiers are held.
+type keyStroke struct {
+	key  imgui.ImguiKey
+	mods imgui.ImguiKey
+}
+
+// printableKeys are the keys of the printable characters other than letters and digits, as typed
+// on a US keyboard. They are reported for the sequences ESC x, sent for characters typed with Alt.
+var printableKeys = map[byte]keyStroke{
+	' ':  {imgui.KeySpace, imgui.KeyModNone},
+	'\'': {imgui.KeyApostrophe, imgui.KeyModNone},
+	'"':  {imgui.KeyApostrophe, imgui.KeyModShift},
+	',':  {imgui.KeyComma, imgui.KeyModNone},
+	'<':  {imgui.KeyComma, imgui.KeyModShift},
+	'-':  {imgui.KeyMinus, imgui.KeyModNone},
+	'_':  {imgui.KeyMinus, imgui.KeyModShift},
+	'.':  {imgui.KeyPeriod, imgui.KeyModNone},
+	'>':  {imgui.KeyPeriod, imgui.KeyModShift},
+	'/':  {imgui.KeySlash, imgui.KeyModNone},
+	'?':  {imgui.KeySlash, imgui.KeyModShift},
+	';':  {imgui.KeySemicolon, imgui.KeyModNone},
+	':':  {imgui.KeySemicolon, imgui.KeyModShift},
+	'=':  {imgui.KeyEqual, imgui.KeyModNone},
+	'+':  {imgui.KeyEqual, imgui.KeyModShift},
+	'{':  {imgui.KeyLeftBracket, imgui.KeyModShift},
+	'\\': {imgui.KeyBackslash, imgui.KeyModNone},
+	'|':  {imgui.KeyBackslash, imgui.KeyModShift},
+	']':  {imgui.KeyRightBracket, imgui.KeyModNone},
+	'}':  {imgui.KeyRightBracket, imgui.KeyModShift},
+	'`':  {imgui.KeyGraveAccent, imgui.KeyModNone},
+	'~':  {imgui.KeyGraveAccent, imgui.KeyModShift},
+	'!':  {imgui.Key1, imgui.KeyModShift},
+	'@':  {imgui.Key2, imgui.KeyModShift},
+	'#':  {imgui.Key3, imgui.KeyModShift},
+	'$':  {imgui.Key4, imgui.KeyModShift},
+	'%':  {imgui.Key5, imgui.KeyModShift},
+	'^':  {imgui.Key6, imgui.KeyModShift},
+	'&':  {imgui.Key7, imgui.KeyModShift},
+	'*':  {imgui.Key8, imgui.KeyModShift},
+	'(':  {imgui.Key9, imgui.KeyModShift},
+	')':  {imgui.Key0, imgui.KeyModShift},
+}
+
+// ss3Keys are the keys of the sequences ESC O x, sent for function keys and in application cursor mode.
+var ss3Keys = map[byte]imgui.ImguiKey{
+	'A': imgui.KeyUpArrow, 'B': imgui.KeyDownArrow, 'C': imgui.KeyRightArrow, 'D': imgui.KeyLeftArrow,
+	'H': imgui.KeyHome, 'F': imgui.KeyEnd,
+	'P': imgui.KeyF1, 'Q': imgui.KeyF2, 'R': imgui.KeyF3, 'S': imgui.KeyF4,
+}
+
+// csiKeys are the keys of the sequences ESC [ x, with x the final byte.
+var csiKeys = map[byte]imgui.ImguiKey{
+	'A': imgui.KeyUpArrow, 'B': imgui.KeyDownArrow, 'C': imgui.KeyRightArrow, 'D': imgui.KeyLeftArrow,
+	'H': imgui.KeyHome, 'F': imgui.KeyEnd,
+	'P': imgui.KeyF1, 'Q': imgui.KeyF2, 'R': imgui.KeyF3, 'S': imgui.KeyF4,
+}
+
+// tildeKeys are the keys of the sequences ESC [ n ~, with n the number.
+var tildeKeys = map[int]imgui.ImguiKey{
+	1: imgui.KeyHome, 2: imgui.KeyInsert, 3: imgui.KeyDelete, 4: imgui.KeyEnd,
+	5: imgui.KeyPageUp, 6: imgui.KeyPageDown, 7: imgui.KeyHome, 8: imgui.KeyEnd,
+	11: imgui.KeyF1, 12: imgui.KeyF2, 13: imgui.KeyF3, 14: imgui.KeyF4,
+	15: imgui.KeyF5, 17: imgui.KeyF6, 18: imgui.KeyF7, 19: imgui.KeyF8,
+	20: imgui.KeyF9, 21: imgui.KeyF10, 23: imgui.KeyF11, 24: imgui.KeyF12,
+}
+
+// csi reports a control sequence ESC [ parameters final. It returns the size of the sequence,
+// or zero if the sequence is incomplete.
+func (input *Input) csi(data []byte) int {
+	end := 2
+	for (end < len(data)) && ((data[end] < 0x40) || (data[end] > 0x7E)) {
+		end++
+	}
+	if end >= len(data) {
+		return 0
+	}
+	params := string(data[2:end])
+	final := data[end]
+	size := end + 1
+
+	if strings.HasPrefix(params, "<") && ((final == 'M') || (final == 'm')) {
+		input.mouse(strings.Split(params[1:], ";"), final == 'M')
+		return size
+	}
+
+	fields := strings.Split(params, ";")
+	mods := imgui.KeyModNone
+	if len(fields) >= 2 {
+		mods = modifiers(fields[1])
+	}
+	switch {
+	case final == '~':
+		number, _ := strconv.Atoi(fields[0])
+		if key, known := tildeKeys[number]; known {
+			input.pressKey(key, mods)
+		}
+	case final == 'Z':
+		input.pressKey(imgui.KeyTab, mods|imgui.KeyModShift)
+	default:
+		if key, known := csiKeys[final]; known {
+			input.pressKey(key, mods)
+		}
+	}
+	return size
+}
+
+// modifiers decodes the modifier parameter of a key sequence, which is one plus a bit mask.
+func modifiers(param string) imgui.ImguiKey {
+	value, err := strconv.Atoi(param)
+	if (err != nil) || (value < 1) {
+		return imgui.KeyModNone
+	}
+	bits := value - 1
+	mods := imgui.KeyModNone
+	if bits&1 != 0 {
+		mods |= imgui.KeyModShift
+	}
+	if bits&2 != 0 {
+		mods |= imgui.KeyModAlt
+	}
+	if bits&4 != 0 {
+		mods |= imgui.KeyModCtrl
+	}
+	if bits&8 != 0 {
+		mods |= imgui.KeyModSuper
+	}
+	return mods
+}
+
+// mouse reports a mouse event in SGR (1006) format: ESC [ < button ; column ; row M (press) or m (release).
+func (input *Input) mouse(fields []string, pressed bool) {
+	if len(fields) != 3 {
+		return
+	}
+	code, errCode := strconv.Atoi(fields[0])
+	column, errColumn := strconv.Atoi(fields[1])
+	row, errRow := strconv.Atoi(fields[2])
+	if (errCode != nil) || (errColumn != nil) || (errRow != nil) {
+		return
+	}
+
+	mods := imgui.KeyModNone
+	if code&4 != 0 {
+		mods |= imgui.KeyModShift
+	}
+	if code&8 != 0 {
+		mods |= imgui.KeyModAlt
+	}
+	if code&16 != 0 {
+		mods |= imgui.KeyModCtrl
+	}
+	input.setMods(mods)
+	input.target.AddMousePosEvent(imgui.Vec2{
+		X: (float32(column) - 0.5) * input.cellSize.X,
+		Y: (float32(row) - 0.5) * input.cellSize.Y,
+	})
+
+	switch {
+	case code&64 != 0:
+		if !pressed {
+			return
+		}
+		switch code & 3 {
+		case 0:
+			input.target.AddMouseWheelEvent(0, 1)
+		case 1:
+			input.target.AddMouseWheelEvent(0, -1)
+		case 2:
+			input.target.AddMouseWheelEvent(1, 0)
+		case 3:
+			input.target.AddMouseWheelEvent(-1, 0)
+		}
+	case code&32 != 0:
+		// motion only
+	default:
+		// terminals number the buttons left, middle, right; ImGui uses left, right, middle.
+		buttons := [3]int{0, 2, 1}
+		if button := code & 3; button < 3 {
+			input.target.AddMouseButtonEvent(buttons[button], pressed)
+		}
+	}
+}
+
+// setMods reports the modifiers that changed since the last mouse event or key.
+func (input *Input) setMods(mods imgui.ImguiKey) {
+	for _, mod := range []imgui.ImguiKey{imgui.KeyModCtrl, imgui.KeyModShift, imgui.KeyModAlt, imgui.KeyModSuper} {
+		if (mods & mod) != (input.mods & mod) {
+			input.target.AddKeyEvent(mod, mods&mod != 0)
+		}
+	}
+	input.mods = mods
+}
+
+// pressKey reports a press and a release of the key, while the modifiers are held.
+func (input *Input) pressKey(key imgui.ImguiKey, mods imgui.ImguiKey) {
+	input.setMods(mods)
+	input.target.AddKeyEvent(key, true)
+	input.target.AddKeyEvent(key, false)
+	input.setMods(imgui.KeyModNone)
+}
@@ -0,0 +1,159 @@
+package termrender_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jetsetilly/imgui-go/v5"
+	"github.com/jetsetilly/imgui-go/v5/termrender"
+)
+
+func newInputContext() (*imgui.Context, imgui.IO) {
+	context := imgui.CreateContext(nil)
+	io := imgui.CurrentIO()
+	io.SetIniFilename("")
+	io.SetDisplaySize(imgui.Vec2{X: 800, Y: 480})
+	io.Fonts().TextureDataRGBA32()
+	return context, io
+}
+
+// frames runs a few frames, for the input queue to deliver the events.
+func frames() {
+	for i := 0; i < 4; i++ {
+		imgui.NewFrame()
+		imgui.Render()
+	}
+}
+
+func TestInputDecodesMouseReports(t *testing.T) {
+	context, io := newInputContext()
+	defer context.Destroy()
+	input := termrender.NewInput(io, imgui.Vec2{X: 8, Y: 16})
+
+	input.Write([]byte("\x1b[<18;11;"))
+	input.Write([]byte("3M"))
+	frames()
+	assert.Equal(t, imgui.Vec2{X: 84, Y: 40}, io.MousePosition(), "position should be the center of the cell")
+	assert.True(t, imgui.IsMouseDown(1), "button 2 should be the right button")
+	assert.True(t, io.KeyCtrlPressed(), "ctrl should follow the mouse report")
+
+	input.Write([]byte("\x1b[<2;11;3m"))
+	frames()
+	assert.False(t, imgui.IsMouseDown(1))
+	assert.False(t, io.KeyCtrlPressed())
+
+	input.Write([]byte("\x1b[<65;11;3M"))
+	imgui.NewFrame()
+	_, vertical := io.MouseWheel()
+	imgui.Render()
+	assert.Equal(t, float32(-1), vertical)
+}
+
+func TestInputDecodesKeys(t *testing.T) {
+	context, io := newInputContext()
+	defer context.Destroy()
+	input := termrender.NewInput(io, imgui.Vec2{X: 8, Y: 16})
+
+	// pressed runs frames until the key is pressed, and records whether ctrl was held then.
+	ctrlHeld := false
+	pressed := func(key imgui.ImguiKey) bool {
+		for i := 0; i < 4; i++ {
+			imgui.NewFrame()
+			wasPressed := imgui.IsKeyPressedV(key, false)
+			ctrlHeld = io.KeyCtrlPressed()
+			imgui.Render()
+			if wasPressed {
+				return true
+			}
+		}
+		return false
+	}
+
+	input.Write([]byte("\x1b[1;5A"))
+	assert.True(t, pressed(imgui.KeyUpArrow))
+	assert.True(t, ctrlHeld, "modifier should be held with the key")
+	frames()
+	assert.False(t, io.KeyCtrlPressed(), "modifier should be released after the key")
+
+	input.Write([]byte("\x1b[3~"))
+	assert.True(t, pressed(imgui.KeyDelete))
+	frames()
+
+	input.Write([]byte("\x1bOQ"))
+	assert.True(t, pressed(imgui.KeyF2))
+	frames()
+
+	input.Write([]byte("\r"))
+	assert.True(t, pressed(imgui.KeyEnter))
+	frames()
+
+	input.Write([]byte{0x1b})
+	assert.False(t, pressed(imgui.KeyEscape), "lone escape should wait for the rest of a sequence")
+	input.Flush()
+	assert.True(t, pressed(imgui.KeyEscape))
+}
+
+func TestInputAddsText(t *testing.T) {
+	context, io := newInputContext()
+	defer context.Destroy()
+	input := termrender.NewInput(io, imgui.Vec2{X: 8, Y: 16})
+
+	text := ""
+	step := func() {
+		imgui.NewFrame()
+		imgui.Begin("input")
+		imgui.SetKeyboardFocusHere()
+		imgui.InputText("text", &text)
+		imgui.End()
+		imgui.Render()
+	}
+	for i := 0; i < 4; i++ {
+		step()
+	}
+	input.Write([]byte("h\xc3"))
+	input.Write([]byte("\xa9!"))
+	for i := 0; i < 4; i++ {
+		step()
+	}
+	assert.Equal(t, "hé!", text)
+}
+
+func TestInputDecodesPrintableCharactersWithAlt(t *testing.T) {
+	context, io := newInputContext()
+	defer context.Destroy()
+	input := termrender.NewInput(io, imgui.Vec2{X: 8, Y: 16})
+
+	// pressed runs frames until the key is pressed, and records the modifiers held then.
+	var altHeld, shiftHeld bool
+	pressed := func(key imgui.ImguiKey) bool {
+		for i := 0; i < 4; i++ {
+			imgui.NewFrame()
+			wasPressed := imgui.IsKeyPressedV(key, false)
+			altHeld, shiftHeld = io.KeyAltPressed(), io.KeyShiftPressed()
+			imgui.Render()
+			if wasPressed {
+				return true
+			}
+		}
+		return false
+	}
+
+	input.Write([]byte("\x1bX"))
+	assert.True(t, pressed(imgui.KeyX))
+	assert.True(t, altHeld, "alt should be held with an uppercase letter")
+	assert.True(t, shiftHeld, "shift should be held with an uppercase letter")
+	assert.False(t, imgui.IsKeyDown(imgui.KeyEscape), "escape should not be reported")
+	frames()
+
+	input.Write([]byte("\x1b."))
+	assert.True(t, pressed(imgui.KeyPeriod))
+	assert.True(t, altHeld)
+	assert.False(t, shiftHeld)
+	frames()
+
+	input.Write([]byte("\x1b?"))
+	assert.True(t, pressed(imgui.KeySlash))
+	assert.True(t, altHeld)
+	assert.True(t, shiftHeld, "shift should be held with a shifted punctuation character")
+}
@@ -0,0 +1,356 @@
+package termrender
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// vertex is a decoded entry of a vertex buffer, with its position in display space.
+type vertex struct {
+	x, y float32
+	u, v float32
+	col  imgui.PackedColor
+}
+
+// rgba is a non-premultiplied color with channels in the range [0,1].
+type rgba struct {
+	r, g, b, a float32
+}
+
+func unpack(col imgui.PackedColor) rgba {
+	const scale = 1.0 / 255.0
+	return rgba{
+		r: float32(uint8(col)) * scale,
+		g: float32(uint8(col>>8)) * scale,
+		b: float32(uint8(col>>16)) * scale,
+		a: float32(uint8(col>>24)) * scale,
+	}
+}
+
+func fromColor(clr color.Color) rgba {
+	r, g, b, a := color.NRGBAModel.Convert(clr).RGBA()
+	const scale = 1.0 / 0xFFFF
+	return rgba{r: float32(r) * scale, g: float32(g) * scale, b: float32(b) * scale, a: float32(a) * scale}
+}
+
+// over blends src over dst, which is opaque.
+func (dst rgba) over(src rgba) rgba {
+	return rgba{
+		r: src.r*src.a + dst.r*(1-src.a),
+		g: src.g*src.a + dst.g*(1-src.a),
+		b: src.b*src.a + dst.b*(1-src.a),
+		a: 1,
+	}
+}
+
+func (col rgba) toRGBA() color.RGBA {
+	channel := func(value float32) uint8 {
+		return uint8(math.Round(float64(min(max(value, 0), 1) * 255)))
+	}
+	return color.RGBA{R: channel(col.r), G: channel(col.g), B: channel(col.b), A: 0xFF}
+}
+
+// uvKey identifies a corner of a glyph in the font atlas.
+type uvKey struct {
+	u, v float32
+}
+
+// cellState is a cell while the draw data is rendered. The upper and lower half are sampled separately.
+type cellState struct {
+	upper, lower rgba
+	glyph        rune
+	glyphColor   rgba
+}
+
+// Renderer renders the draw data of an ImGui frame into a Screen.
+//
+// A Renderer is not safe for concurrent use.
+type Renderer struct {
+	fontTexture imgui.TextureID
+	glyphsByMin map[uvKey]rune
+	glyphsByMax map[uvKey]rune
+	cellSize    imgui.Vec2
+	clearColor  rgba
+
+	vertices []vertex
+	cells    []cellState
+	columns  int
+	rows     int
+}
+
+// defaultCellSize is used if the font atlas has no fonts.
+var defaultCellSize = imgui.Vec2{X: 8, Y: 16}
+
+// printableRunes are the runes that are mapped for the first font of the atlas: ASCII and Latin-1.
+var printableRunes = func() []rune {
+	var runes []rune
+	for r := rune(0x20); r < 0x7F; r++ {
+		runes = append(runes, r)
+	}
+	for r := rune(0xA0); r <= 0xFF; r++ {
+		runes = append(runes, r)
+	}
+	return runes
+}()
+
+// NewRenderer returns a renderer for the font atlas. The atlas is built if necessary.
+//
+// The printable ASCII and Latin-1 runes of the first font of the atlas are mapped, and the cell size
+// is the advance of "M" and the size of that font. Use MapRunes() to map further runes or fonts.
+// The clear color is black.
+func NewRenderer(atlas imgui.FontAtlas) *Renderer {
+	atlas.TextureDataRGBA32()
+	renderer := &Renderer{
+		fontTexture: atlas.TextureID(),
+		glyphsByMin: make(map[uvKey]rune),
+		glyphsByMax: make(map[uvKey]rune),
+		cellSize:    defaultCellSize,
+		clearColor:  rgba{a: 1},
+	}
+
+	if fonts := atlas.Fonts(); len(fonts) > 0 {
+		font := fonts[0]
+		renderer.MapRunes(font, printableRunes...)
+		if width := font.FindGlyph('M').AdvanceX(); (width > 0) && (font.FontSize() > 0) {
+			renderer.cellSize = imgui.Vec2{X: width, Y: font.FontSize()}
+		}
+	}
+	return renderer
+}
+
+// MapRunes maps the glyphs of the given runes of the font, so that quads showing them are rendered as the rune.
+// Runes the font has no glyph for are ignored.
+func (renderer *Renderer) MapRunes(font imgui.Font, runes ...rune) {
+	for _, r := range runes {
+		glyph := font.FindGlyph(r)
+		if (glyph == 0) || (rune(glyph.Codepoint()) != r) || !glyph.Visible() {
+			continue
+		}
+		renderer.glyphsByMin[uvKey{u: glyph.U0(), v: glyph.V0()}] = r
+		renderer.glyphsByMax[uvKey{u: glyph.U1(), v: glyph.V1()}] = r
+	}
+}
+
+// CellSize returns the size of a cell, in display units.
+func (renderer *Renderer) CellSize() imgui.Vec2 {
+	return renderer.cellSize
+}
+
+// SetCellSize sets the size of a cell, in display units.
+func (renderer *Renderer) SetCellSize(size imgui.Vec2) {
+	renderer.cellSize = size
+}
+
+// DisplaySize returns the display size for a screen of the given number of columns and rows.
+func (renderer *Renderer) DisplaySize(columns, rows int) imgui.Vec2 {
+	return imgui.Vec2{X: float32(columns) * renderer.cellSize.X, Y: float32(rows) * renderer.cellSize.Y}
+}
+
+// SetClearColor sets the color that Render() fills a new screen with before drawing.
+func (renderer *Renderer) SetClearColor(clr color.Color) {
+	renderer.clearColor = fromColor(clr)
+	renderer.clearColor.a = 1
+}
+
+// Render renders the draw data into a new screen. The size of the screen is the display size of the draw data,
+// divided by the cell size and rounded up. Draw data that is not valid results in an empty screen.
+//
+// Draw commands with a user callback are skipped. Textures other than the font atlas are not sampled;
+// their triangles are filled with their vertex colors.
+func (renderer *Renderer) Render(data imgui.DrawData) *Screen {
+	if !data.Valid() {
+		return NewScreen(0, 0)
+	}
+	size := data.DisplaySize()
+	renderer.columns = int(math.Ceil(float64(size.X / renderer.cellSize.X)))
+	renderer.rows = int(math.Ceil(float64(size.Y / renderer.cellSize.Y)))
+	renderer.cells = renderer.cells[:0]
+	for i := 0; i < renderer.columns*renderer.rows; i++ {
+		renderer.cells = append(renderer.cells, cellState{upper: renderer.clearColor, lower: renderer.clearColor})
+	}
+
+	pos := data.DisplayPos()
+	for _, list := range data.CommandLists() {
+		renderer.readVertices(list, pos)
+		listIndices := list.Indices()
+
+		for _, cmd := range list.Commands() {
+			if cmd.HasUserCallback() {
+				continue
+			}
+			clipRect := cmd.ClipRect()
+			clip := [4]float32{clipRect.X - pos.X, clipRect.Y - pos.Y, clipRect.Z - pos.X, clipRect.W - pos.Y}
+			fontTexture := cmd.TextureID() == renderer.fontTexture
+			vertices := renderer.vertices[cmd.VertexOffset():]
+			indices := listIndices[cmd.IndexOffset() : cmd.IndexOffset()+cmd.ElementCount()]
+			for i := 0; i+2 < len(indices); i += 3 {
+				renderer.triangle(vertices[indices[i]], vertices[indices[i+1]], vertices[indices[i+2]], clip, fontTexture)
+			}
+		}
+	}
+	return renderer.compose()
+}
+
+// triangle renders a triangle: as a glyph, if it is part of a glyph quad of the font atlas, or as a filled shape.
+func (renderer *Renderer) triangle(v0, v1, v2 vertex, clip [4]float32, fontTexture bool) {
+	textured := (v0.u != v1.u) || (v0.u != v2.u) || (v0.v != v1.v) || (v0.v != v2.v)
+	if textured && fontTexture {
+		renderer.glyph(v0, v1, v2, clip)
+		return
+	}
+	renderer.fill(v0, v1, v2, clip)
+}
+
+// glyph places the rune of a glyph quad in the cell of its center. Quads of both triangles place the same rune.
+// Textured triangles that don't match a glyph, such as the baked lines of the atlas, are ignored.
+func (renderer *Renderer) glyph(v0, v1, v2 vertex, clip [4]float32) {
+	uvMin := uvKey{u: min(v0.u, v1.u, v2.u), v: min(v0.v, v1.v, v2.v)}
+	uvMax := uvKey{u: max(v0.u, v1.u, v2.u), v: max(v0.v, v1.v, v2.v)}
+	r, found := renderer.glyphsByMin[uvMin]
+	if !found {
+		if r, found = renderer.glyphsByMax[uvMax]; !found {
+			return
+		}
+	}
+	x := (min(v0.x, v1.x, v2.x) + max(v0.x, v1.x, v2.x)) / 2
+	y := (min(v0.y, v1.y, v2.y) + max(v0.y, v1.y, v2.y)) / 2
+	if (x < clip[0]) || (x >= clip[2]) || (y < clip[1]) || (y >= clip[3]) {
+		return
+	}
+	cell := renderer.cellAt(int(x/renderer.cellSize.X), int(y/renderer.cellSize.Y))
+	if cell == nil {
+		return
+	}
+	cell.glyph = r
+	cell.glyphColor = unpack(v0.col)
+}
+
+func (renderer *Renderer) cellAt(column, row int) *cellState {
+	if (column < 0) || (column >= renderer.columns) || (row < 0) || (row >= renderer.rows) {
+		return nil
+	}
+	return &renderer.cells[row*renderer.columns+column]
+}
+
+// edge is the edge function of the line a->b, evaluated at point (x, y).
+func edge(a, b vertex, x, y float32) float32 {
+	return (b.x-a.x)*(y-a.y) - (b.y-a.y)*(x-a.x)
+}
+
+// isTopLeft returns true if the edge a->b is a top or a left edge, for a triangle with a positive area.
+func isTopLeft(a, b vertex) bool {
+	dx := b.x - a.x
+	dy := b.y - a.y
+	return ((dy == 0) && (dx > 0)) || (dy < 0)
+}
+
+func covers(w float32, topLeft bool) bool {
+	return (w > 0) || ((w == 0) && topLeft)
+}
+
+// fill blends the triangle into the samples of the cells, at the center of the upper and lower half of every cell.
+// A glyph is removed from a cell if a sample is covered with an alpha of at least one half.
+func (renderer *Renderer) fill(v0, v1, v2 vertex, clip [4]float32) {
+	area := edge(v0, v1, v2.x, v2.y)
+	if area == 0 {
+		return
+	}
+	if area < 0 {
+		v1, v2 = v2, v1
+		area = -area
+	}
+	c0, c1, c2 := unpack(v0.col), unpack(v1.col), unpack(v2.col)
+	topLeft0, topLeft1, topLeft2 := isTopLeft(v1, v2), isTopLeft(v2, v0), isTopLeft(v0, v1)
+
+	sampleHeight := renderer.cellSize.Y / 2
+	minX := max(min(v0.x, v1.x, v2.x), clip[0])
+	maxX := min(max(v0.x, v1.x, v2.x), clip[2])
+	minY := max(min(v0.y, v1.y, v2.y), clip[1])
+	maxY := min(max(v0.y, v1.y, v2.y), clip[3])
+	firstColumn := max(int(math.Floor(float64(minX/renderer.cellSize.X-0.5))), 0)
+	lastColumn := min(int(math.Ceil(float64(maxX/renderer.cellSize.X-0.5))), renderer.columns-1)
+	firstSample := max(int(math.Floor(float64(minY/sampleHeight-0.5))), 0)
+	lastSample := min(int(math.Ceil(float64(maxY/sampleHeight-0.5))), renderer.rows*2-1)
+
+	for sample := firstSample; sample <= lastSample; sample++ {
+		y := (float32(sample) + 0.5) * sampleHeight
+		if (y < clip[1]) || (y >= clip[3]) {
+			continue
+		}
+		for column := firstColumn; column <= lastColumn; column++ {
+			x := (float32(column) + 0.5) * renderer.cellSize.X
+			if (x < clip[0]) || (x >= clip[2]) {
+				continue
+			}
+			w0 := edge(v1, v2, x, y)
+			w1 := edge(v2, v0, x, y)
+			w2 := edge(v0, v1, x, y)
+			if !covers(w0, topLeft0) || !covers(w1, topLeft1) || !covers(w2, topLeft2) {
+				continue
+			}
+			b0, b1, b2 := w0/area, w1/area, w2/area
+			src := rgba{
+				r: c0.r*b0 + c1.r*b1 + c2.r*b2,
+				g: c0.g*b0 + c1.g*b1 + c2.g*b2,
+				b: c0.b*b0 + c1.b*b1 + c2.b*b2,
+				a: c0.a*b0 + c1.a*b1 + c2.a*b2,
+			}
+			cell := renderer.cellAt(column, sample/2)
+			if sample%2 == 0 {
+				cell.upper = cell.upper.over(src)
+			} else {
+				cell.lower = cell.lower.over(src)
+			}
+			if src.a >= 0.5 {
+				cell.glyph = 0
+			}
+		}
+	}
+}
+
+// compose turns the sampled cells into a screen. Cells with a glyph show the rune on the average of both halves;
+// other cells show a space if both halves are equal, or an upper half block.
+func (renderer *Renderer) compose() *Screen {
+	screen := NewScreen(renderer.columns, renderer.rows)
+	for i, state := range renderer.cells {
+		upper, lower := state.upper.toRGBA(), state.lower.toRGBA()
+		cell := &screen.Cells[i]
+		switch {
+		case state.glyph != 0:
+			background := rgba{
+				r: (state.upper.r + state.lower.r) / 2,
+				g: (state.upper.g + state.lower.g) / 2,
+				b: (state.upper.b + state.lower.b) / 2,
+				a: 1,
+			}
+			cell.Rune = state.glyph
+			cell.Foreground = background.over(state.glyphColor).toRGBA()
+			cell.Background = background.toRGBA()
+		case upper == lower:
+			cell.Rune = ' '
+			cell.Foreground = upper
+			cell.Background = upper
+		default:
+			cell.Rune = '▀'
+			cell.Foreground = upper
+			cell.Background = lower
+		}
+	}
+	return screen
+}
+
+// readVertices reads the vertices of the list, transforming the positions relative to the display position.
+func (renderer *Renderer) readVertices(list imgui.DrawList, displayPos imgui.Vec2) {
+	renderer.vertices = renderer.vertices[:0]
+	for _, v := range list.Vertices() {
+		renderer.vertices = append(renderer.vertices, vertex{
+			x:   v.Pos.X - displayPos.X,
+			y:   v.Pos.Y - displayPos.Y,
+			u:   v.UV.X,
+			v:   v.UV.Y,
+			col: v.Col,
+		})
+	}
+}
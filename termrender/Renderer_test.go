@@ -0,0 +1,65 @@
+package termrender_test
+
+import (
+	"bytes"
+	"image/color"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+	"github.com/jetsetilly/imgui-go/v5/termrender"
+)
+
+func TestRenderMapsGlyphsAndFillsCells(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	io := imgui.CurrentIO()
+	io.SetIniFilename("")
+	renderer := termrender.NewRenderer(io.Fonts())
+	cell := renderer.CellSize()
+	require.True(t, (cell.X > 0) && (cell.Y > 0), "cell size should come from the font")
+	io.SetDisplaySize(renderer.DisplaySize(60, 20))
+
+	for i := 0; i < 2; i++ {
+		imgui.NewFrame()
+		imgui.SetNextWindowPos(imgui.Vec2{X: 2 * cell.X, Y: 2 * cell.Y})
+		imgui.SetNextWindowSize(imgui.Vec2{X: 40 * cell.X, Y: 10 * cell.Y})
+		imgui.Begin("terminal")
+		imgui.Text("Hello, world")
+		imgui.End()
+		imgui.Render()
+	}
+
+	screen := renderer.Render(imgui.RenderedDrawData())
+	require.Equal(t, 60, screen.Columns)
+	require.Equal(t, 20, screen.Rows)
+	assert.Contains(t, screen.Text(), "Hello, world", "text should be mapped back to runes")
+	assert.Contains(t, screen.Text(), "terminal", "title should be mapped back to runes")
+
+	black := color.RGBA{A: 0xFF}
+	assert.Equal(t, termrender.Cell{Rune: ' ', Foreground: black, Background: black}, screen.At(55, 18), "cell outside window should be cleared")
+	inside := screen.At(20, 8)
+	assert.NotEqual(t, black, inside.Background, "cell inside window should be filled")
+
+	var out bytes.Buffer
+	n, err := screen.WriteTo(&out)
+	require.NoError(t, err)
+	assert.Equal(t, int64(out.Len()), n)
+	assert.True(t, strings.HasPrefix(out.String(), "\x1b[H"))
+	assert.Contains(t, out.String(), "\x1b[48;2;")
+	assert.Equal(t, 19, strings.Count(out.String(), "\r\n"))
+}
+
+func TestRenderInvalidDrawData(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+
+	renderer := termrender.NewRenderer(imgui.CurrentIO().Fonts())
+	screen := renderer.Render(imgui.RenderedDrawData())
+	assert.Equal(t, 0, screen.Columns)
+	assert.Equal(t, "", screen.Text())
+}
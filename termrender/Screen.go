@@ -0,0 +1,103 @@
+package termrender
+
+import (
+	"bufio"
+	"fmt"
+	"image/color"
+	"io"
+	"strings"
+)
+
+// MouseReportingOn enables the reporting of mouse buttons and motion, in SGR (1006) format.
+const MouseReportingOn = "\x1b[?1003h\x1b[?1006h"
+
+// MouseReportingOff disables the reporting of the mouse.
+const MouseReportingOff = "\x1b[?1006l\x1b[?1003l"
+
+// Cell is a character of the screen.
+type Cell struct {
+	Rune       rune
+	Foreground color.RGBA
+	Background color.RGBA
+}
+
+// Screen is a grid of cells, as produced by Renderer.Render().
+type Screen struct {
+	Columns int
+	Rows    int
+	// Cells are the cells of the screen, row by row.
+	Cells []Cell
+}
+
+// NewScreen returns a screen of the given size, filled with spaces on black.
+func NewScreen(columns, rows int) *Screen {
+	screen := &Screen{Columns: columns, Rows: rows, Cells: make([]Cell, columns*rows)}
+	for i := range screen.Cells {
+		screen.Cells[i] = Cell{Rune: ' ', Foreground: color.RGBA{A: 0xFF}, Background: color.RGBA{A: 0xFF}}
+	}
+	return screen
+}
+
+// At returns the cell at the given position. Positions outside the screen return an empty cell.
+func (screen *Screen) At(column, row int) Cell {
+	if (column < 0) || (column >= screen.Columns) || (row < 0) || (row >= screen.Rows) {
+		return Cell{}
+	}
+	return screen.Cells[row*screen.Columns+column]
+}
+
+// Text returns the runes of the screen without colors, one line per row.
+// Trailing spaces of the rows are removed.
+func (screen *Screen) Text() string {
+	var text strings.Builder
+	for row := 0; row < screen.Rows; row++ {
+		line := make([]rune, screen.Columns)
+		for column := range line {
+			line[column] = screen.At(column, row).Rune
+		}
+		text.WriteString(strings.TrimRight(string(line), " "))
+		if row+1 < screen.Rows {
+			text.WriteByte('\n')
+		}
+	}
+	return text.String()
+}
+
+// WriteTo writes the screen to a terminal: it moves the cursor to the upper-left corner, writes all rows
+// with 24-bit color escape sequences, and resets the colors. Colors are only written where they change.
+func (screen *Screen) WriteTo(w io.Writer) (int64, error) {
+	counter := &countingWriter{w: w}
+	out := bufio.NewWriter(counter)
+	out.WriteString("\x1b[H")
+	for row := 0; row < screen.Rows; row++ {
+		var last Cell
+		for column := 0; column < screen.Columns; column++ {
+			cell := screen.At(column, row)
+			if (column == 0) || (cell.Foreground != last.Foreground) {
+				fmt.Fprintf(out, "\x1b[38;2;%d;%d;%dm", cell.Foreground.R, cell.Foreground.G, cell.Foreground.B)
+			}
+			if (column == 0) || (cell.Background != last.Background) {
+				fmt.Fprintf(out, "\x1b[48;2;%d;%d;%dm", cell.Background.R, cell.Background.G, cell.Background.B)
+			}
+			out.WriteRune(cell.Rune)
+			last = cell
+		}
+		if row+1 < screen.Rows {
+			out.WriteString("\x1b[0m\r\n")
+		}
+	}
+	out.WriteString("\x1b[0m")
+	err := out.Flush()
+	return counter.count, err
+}
+
+type countingWriter struct {
+	w     io.Writer
+	count int64
+}
+
+func (counter *countingWriter) Write(p []byte) (int, error) {
+	n, err := counter.w.Write(p)
+	counter.count += int64(n)
+	return n, err
+}
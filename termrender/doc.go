@@ -0,0 +1,43 @@
+// Package termrender renders imgui.DrawData into a grid of characters for ANSI terminals,
+// and feeds terminal input back into imgui.IO.
+//
+// The Renderer divides the display into cells of a fixed size, by default the advance and height
+// of the font. Quads that show a glyph of the font atlas are mapped back to their rune; all other
+// triangles are sampled twice per cell, in the upper and the lower half, and shown with background
+// colors and the half block "▀". The Screen is written with 24-bit color escape sequences.
+//
+// The Input adapter decodes the bytes read from the terminal: printable text, control characters,
+// the escape sequences of cursor and function keys, and mouse reports in SGR (1006) format.
+// The terminal must be in raw mode, and mouse reporting must be enabled with MouseReportingOn.
+//
+// A typical loop, for a display of 100x30 cells with the default font:
+//
+//	renderer := termrender.NewRenderer(io.Fonts())
+//	input := termrender.NewInput(io, renderer.CellSize())
+//	io.SetDisplaySize(renderer.DisplaySize(100, 30))
+//	os.Stdout.WriteString(termrender.MouseReportingOn)
+//	defer os.Stdout.WriteString(termrender.MouseReportingOff)
+//	keys := make(chan []byte)
+//	go readKeys(os.Stdin, keys)
+//
+//	for {
+//		for pending := true; pending; {
+//			select {
+//			case data := <-keys:
+//				input.Write(data)
+//			default:
+//				pending = false
+//			}
+//		}
+//		imgui.NewFrame()
+//		// ...
+//		imgui.Render()
+//		renderer.Render(imgui.RenderedDrawData()).WriteTo(os.Stdout)
+//	}
+//
+// Input calls the functions of imgui.IO, so it must be written to from the goroutine that runs the frames.
+//
+// Terminals report key presses, but not key releases. The Input adapter reports a press and a release
+// for every key, which relies on the input queue of ImGui to spread them over consecutive frames;
+// see IO.SetConfigInputTrickleEventQueue().
+package termrender
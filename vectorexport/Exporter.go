@@ -0,0 +1,273 @@
+package vectorexport
+
+import (
+	"image"
+	"image/color"
+	"image/draw"
+	"unsafe"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// Exporter converts the draw data of an ImGui frame into vector graphics.
+//
+// An Exporter is not safe for concurrent use.
+type Exporter struct {
+	textures    map[imgui.TextureID]*image.NRGBA
+	fontTexture imgui.TextureID
+	hasFont     bool
+}
+
+// NewExporter returns an exporter without any registered textures.
+func NewExporter() *Exporter {
+	return &Exporter{textures: make(map[imgui.TextureID]*image.NRGBA)}
+}
+
+// RegisterTexture makes the image available to draw commands that refer to the given texture ID.
+// The image is copied, later changes to it are not seen by the exporter.
+// Registering an image for an ID that is already in use replaces the previous image.
+func (exporter *Exporter) RegisterTexture(id imgui.TextureID, img image.Image) {
+	bounds := img.Bounds()
+	texture := image.NewNRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(texture, texture.Bounds(), img, bounds.Min, draw.Src)
+	exporter.textures[id] = texture
+}
+
+// UnregisterTexture removes the image for the given texture ID.
+// Draw commands that refer to an unregistered texture are not exported.
+func (exporter *Exporter) UnregisterTexture(id imgui.TextureID) {
+	delete(exporter.textures, id)
+	if exporter.hasFont && (exporter.fontTexture == id) {
+		exporter.hasFont = false
+	}
+}
+
+// RegisterFontAtlas registers the RGBA32 texture data of the font atlas, using the texture ID of the atlas.
+// Quads that show a part of the font atlas, such as glyphs, are exported as masks of that part,
+// filled with the color of the quad.
+// The atlas must be registered again if its texture ID or its fonts change.
+func (exporter *Exporter) RegisterFontAtlas(atlas imgui.FontAtlas) {
+	data := atlas.TextureDataRGBA32()
+	texture := image.NewNRGBA(image.Rect(0, 0, data.Width, data.Height))
+	if data.Pixels != nil {
+		copy(texture.Pix, unsafe.Slice((*byte)(data.Pixels), len(texture.Pix)))
+	}
+	exporter.textures[atlas.TextureID()] = texture
+	exporter.fontTexture = atlas.TextureID()
+	exporter.hasFont = true
+}
+
+// rect is an axis aligned rectangle.
+type rect struct {
+	minX, minY, maxX, maxY float32
+}
+
+func (r rect) width() float32  { return r.maxX - r.minX }
+func (r rect) height() float32 { return r.maxY - r.minY }
+
+// page is the path model of a frame, which the SVG and the PDF writer share.
+// All coordinates are relative to the display position of the frame.
+type page struct {
+	width, height float32
+	groups        []group
+}
+
+// group contains the shapes of consecutive draw commands with the same clip rectangle.
+type group struct {
+	clip   rect
+	shapes []shape
+}
+
+type shapeKind int
+
+const (
+	// shapeFill is a set of triangles, filled with a single color.
+	shapeFill shapeKind = iota
+	// shapeMask is a rectangle of the font atlas, filled with a single color.
+	shapeMask
+	// shapeImage is a rectangle of a texture, drawn with the alpha of the color.
+	shapeImage
+)
+
+type shape struct {
+	kind  shapeKind
+	color color.NRGBA
+
+	// triangles of shapeFill, as x, y pairs. All triangles have the same winding.
+	triangles []float32
+
+	// target, and the source within the texture, of shapeMask and shapeImage.
+	target  rect
+	texture imgui.TextureID
+	source  image.Rectangle
+}
+
+type vertex struct {
+	x, y, u, v float32
+	col        imgui.PackedColor
+}
+
+// build converts the draw data into the path model.
+func (exporter *Exporter) build(data imgui.DrawData) *page {
+	if !data.Valid() {
+		return &page{}
+	}
+	pos := data.DisplayPos()
+	size := data.DisplaySize()
+	result := &page{width: size.X, height: size.Y}
+
+	var vertices []vertex
+	for _, list := range data.CommandLists() {
+		vertices = readVertices(vertices[:0], list, pos)
+		indices := list.Indices()
+
+		for _, cmd := range list.Commands() {
+			if cmd.HasUserCallback() {
+				continue
+			}
+			texture, registered := exporter.textures[cmd.TextureID()]
+			if !registered {
+				continue
+			}
+			clipRect := cmd.ClipRect()
+			clip := rect{minX: clipRect.X - pos.X, minY: clipRect.Y - pos.Y, maxX: clipRect.Z - pos.X, maxY: clipRect.W - pos.Y}
+			if (clip.width() <= 0) || (clip.height() <= 0) {
+				continue
+			}
+			if (len(result.groups) == 0) || (result.groups[len(result.groups)-1].clip != clip) {
+				result.groups = append(result.groups, group{clip: clip})
+			}
+			target := &result.groups[len(result.groups)-1]
+
+			cmdVertices := vertices[cmd.VertexOffset():]
+			cmdIndices := indices[cmd.IndexOffset() : cmd.IndexOffset()+cmd.ElementCount()]
+			for i := 0; i+2 < len(cmdIndices); i += 3 {
+				if (i+5 < len(cmdIndices)) && exporter.addQuad(target, cmd.TextureID(), texture, cmdVertices, cmdIndices[i:i+6]) {
+					i += 3
+					continue
+				}
+				addTriangle(target, texture, cmdVertices[cmdIndices[i]], cmdVertices[cmdIndices[i+1]], cmdVertices[cmdIndices[i+2]])
+			}
+		}
+	}
+	return result
+}
+
+// addQuad adds the two triangles as a textured rectangle, if they form one.
+// These are the quads of glyphs and images, with the vertices of the corners in the order
+// upper left, upper right, lower right, lower left, and the triangles (0, 1, 2) and (0, 2, 3).
+func (exporter *Exporter) addQuad(target *group, id imgui.TextureID, texture *image.NRGBA, vertices []vertex, indices []imgui.DrawIdx) bool {
+	if (indices[0] != indices[3]) || (indices[2] != indices[4]) {
+		return false
+	}
+	a, b, c, d := vertices[indices[0]], vertices[indices[1]], vertices[indices[2]], vertices[indices[5]]
+	if (a.col != b.col) || (a.col != c.col) || (a.col != d.col) {
+		return false
+	}
+	if (a.y != b.y) || (b.x != c.x) || (c.y != d.y) || (d.x != a.x) || (a.x >= c.x) || (a.y >= c.y) {
+		return false
+	}
+	if (a.v != b.v) || (b.u != c.u) || (c.v != d.v) || (d.u != a.u) || (a.u == c.u) || (a.v == c.v) {
+		return false
+	}
+
+	bounds := texture.Bounds()
+	source := image.Rect(
+		int(min(a.u, c.u)*float32(bounds.Dx())+0.5), int(min(a.v, c.v)*float32(bounds.Dy())+0.5),
+		int(max(a.u, c.u)*float32(bounds.Dx())+0.5), int(max(a.v, c.v)*float32(bounds.Dy())+0.5),
+	).Intersect(bounds)
+	if source.Empty() {
+		return false
+	}
+
+	kind := shapeImage
+	if exporter.hasFont && (id == exporter.fontTexture) {
+		kind = shapeMask
+	}
+	target.shapes = append(target.shapes, shape{
+		kind:    kind,
+		color:   nrgba(a.col),
+		target:  rect{minX: a.x, minY: a.y, maxX: c.x, maxY: c.y},
+		texture: id,
+		source:  source,
+	})
+	return true
+}
+
+// addTriangle adds the triangle to the fill of the previous shape, if that has the same color,
+// or starts a new fill. The color is the average of the vertex colors, multiplied by the texel
+// at the center of the triangle: for the solid shapes of ImGui, which all sample the white texel
+// of the font atlas, this is exact.
+func addTriangle(target *group, texture *image.NRGBA, v0, v1, v2 vertex) {
+	clr := averageColor(v0.col, v1.col, v2.col)
+	texel := sample(texture, (v0.u+v1.u+v2.u)/3, (v0.v+v1.v+v2.v)/3)
+	clr.R = uint8((int(clr.R)*int(texel.R) + 127) / 255)
+	clr.G = uint8((int(clr.G)*int(texel.G) + 127) / 255)
+	clr.B = uint8((int(clr.B)*int(texel.B) + 127) / 255)
+	clr.A = uint8((int(clr.A)*int(texel.A) + 127) / 255)
+	if clr.A == 0 {
+		return
+	}
+
+	// a fill uses the nonzero rule, so all triangles must wind the same way for their union to be filled.
+	if (v1.x-v0.x)*(v2.y-v0.y)-(v1.y-v0.y)*(v2.x-v0.x) < 0 {
+		v1, v2 = v2, v1
+	}
+	if (len(target.shapes) == 0) || (target.shapes[len(target.shapes)-1].kind != shapeFill) ||
+		(target.shapes[len(target.shapes)-1].color != clr) {
+		target.shapes = append(target.shapes, shape{kind: shapeFill, color: clr})
+	}
+	fill := &target.shapes[len(target.shapes)-1]
+	fill.triangles = append(fill.triangles, v0.x, v0.y, v1.x, v1.y, v2.x, v2.y)
+}
+
+// averageColor returns the average of the colors, weighting the color channels by alpha.
+func averageColor(colors ...imgui.PackedColor) color.NRGBA {
+	var r, g, b, a int
+	for _, packed := range colors {
+		clr := nrgba(packed)
+		r += int(clr.R) * int(clr.A)
+		g += int(clr.G) * int(clr.A)
+		b += int(clr.B) * int(clr.A)
+		a += int(clr.A)
+	}
+	if a == 0 {
+		return color.NRGBA{}
+	}
+	return color.NRGBA{
+		R: uint8((r + a/2) / a),
+		G: uint8((g + a/2) / a),
+		B: uint8((b + a/2) / a),
+		A: uint8((a + len(colors)/2) / len(colors)),
+	}
+}
+
+// nrgba unpacks the color of a vertex, which is not premultiplied.
+func nrgba(packed imgui.PackedColor) color.NRGBA {
+	return color.NRGBA{R: uint8(packed), G: uint8(packed >> 8), B: uint8(packed >> 16), A: uint8(packed >> 24)}
+}
+
+// sample returns the texel at the texture coordinates, clamped to the edges.
+func sample(texture *image.NRGBA, u, v float32) color.NRGBA {
+	bounds := texture.Bounds()
+	if bounds.Empty() {
+		return color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: 0xFF}
+	}
+	x := min(max(int(u*float32(bounds.Dx())), 0), bounds.Dx()-1)
+	y := min(max(int(v*float32(bounds.Dy())), 0), bounds.Dy()-1)
+	return texture.NRGBAAt(bounds.Min.X+x, bounds.Min.Y+y)
+}
+
+// readVertices reads the vertices of the list, relative to the display position.
+func readVertices(vertices []vertex, list imgui.DrawList, displayPos imgui.Vec2) []vertex {
+	for _, v := range list.Vertices() {
+		vertices = append(vertices, vertex{
+			x:   v.Pos.X - displayPos.X,
+			y:   v.Pos.Y - displayPos.Y,
+			u:   v.UV.X,
+			v:   v.UV.Y,
+			col: v.Col,
+		})
+	}
+	return vertices
+}
@@ -0,0 +1,118 @@
+package vectorexport_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"image"
+	"image/color"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jetsetilly/imgui-go/v5"
+	"github.com/jetsetilly/imgui-go/v5/vectorexport"
+)
+
+const testTexture imgui.TextureID = 42
+
+func renderFrame(t *testing.T) (*vectorexport.Exporter, imgui.DrawData) {
+	t.Helper()
+	io := imgui.CurrentIO()
+	io.SetIniFilename("")
+	io.SetDisplaySize(imgui.Vec2{X: 320, Y: 240})
+
+	exporter := vectorexport.NewExporter()
+	exporter.RegisterFontAtlas(io.Fonts())
+	texture := image.NewNRGBA(image.Rect(0, 0, 4, 4))
+	for i := range texture.Pix {
+		texture.Pix[i] = 0xFF
+	}
+	texture.SetNRGBA(0, 0, color.NRGBA{R: 0xFF, A: 0xFF})
+	exporter.RegisterTexture(testTexture, texture)
+
+	for i := 0; i < 2; i++ {
+		imgui.NewFrame()
+		imgui.SetNextWindowPos(imgui.Vec2{X: 10, Y: 10})
+		imgui.SetNextWindowSize(imgui.Vec2{X: 200, Y: 150})
+		imgui.Begin("export")
+		imgui.Text("Hello, world")
+		imgui.Image(testTexture, imgui.Vec2{X: 16, Y: 16})
+		imgui.WindowDrawList().AddRectFilled(imgui.Vec2{X: 50, Y: 100}, imgui.Vec2{X: 90, Y: 120}, imgui.Packed(color.RGBA{G: 0xFF, A: 0xFF}))
+		imgui.End()
+		imgui.Render()
+	}
+	return exporter, imgui.RenderedDrawData()
+}
+
+func TestWriteSVG(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	exporter, data := renderFrame(t)
+
+	var out bytes.Buffer
+	require.NoError(t, exporter.WriteSVG(&out, data))
+	svg := out.String()
+
+	decoder := xml.NewDecoder(strings.NewReader(svg))
+	for {
+		_, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err, "document should be well-formed XML")
+	}
+
+	assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg" width="320" height="240"`))
+	assert.Contains(t, svg, "<clipPath id=\"clip0\">", "clip rects should become clip paths")
+	assert.Contains(t, svg, `<g clip-path="url(#clip0)">`)
+	assert.Regexp(t, `<path d="M50\.5 101\.207L[^"]*Z M50\.5 101\.207L[^"]*" fill="#00ff00"/>`, svg,
+		"solid triangles should be merged into one path")
+	assert.Contains(t, svg, `mask="url(#mask0)"`, "glyphs should be masks of the font atlas")
+	assert.Contains(t, svg, `fill="url(#image0)"`, "other textures should become patterns")
+	assert.Equal(t, 1, strings.Count(svg, `<pattern id=`), "texture should be embedded once")
+	assert.True(t, strings.Count(svg, "<mask id=") < strings.Count(svg, `mask="url(`), "repeated glyphs should share their mask")
+}
+
+func TestWritePDF(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	exporter, data := renderFrame(t)
+
+	var out bytes.Buffer
+	require.NoError(t, exporter.WritePDF(&out, data))
+	pdf := out.String()
+
+	assert.True(t, strings.HasPrefix(pdf, "%PDF-1.4\n"))
+	assert.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+	assert.Contains(t, pdf, "/MediaBox [0 0 320 240]")
+
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindStringSubmatch(pdf)
+	require.NotNil(t, startxref)
+	xref, _ := strconv.Atoi(startxref[1])
+	require.True(t, strings.HasPrefix(pdf[xref:], "xref\n"), "startxref should point at the cross-reference table")
+	entries := regexp.MustCompile(`(\d{10}) 00000 n `).FindAllStringSubmatch(pdf[xref:], -1)
+	assert.True(t, len(entries) > 5, "document should contain images")
+	for i, entry := range entries {
+		offset, _ := strconv.Atoi(entry[1])
+		assert.True(t, strings.HasPrefix(pdf[offset:], strconv.Itoa(i+1)+" 0 obj\n"), "offset of object %d should be correct", i+1)
+	}
+}
+
+func TestWriteInvalidDrawData(t *testing.T) {
+	context := imgui.CreateContext(nil)
+	defer context.Destroy()
+	exporter := vectorexport.NewExporter()
+
+	var svg bytes.Buffer
+	require.NoError(t, exporter.WriteSVG(&svg, imgui.RenderedDrawData()))
+	assert.Equal(t, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"0\" height=\"0\" viewBox=\"0 0 0 0\">\n</svg>\n", svg.String())
+
+	var pdf bytes.Buffer
+	require.NoError(t, exporter.WritePDF(&pdf, imgui.RenderedDrawData()))
+	assert.True(t, strings.HasSuffix(pdf.String(), "%%EOF\n"))
+}
@@ -0,0 +1,239 @@
+package vectorexport
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image/color"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// WritePDF writes the draw data as a single page PDF document, with one point per display unit.
+// It uses the same path model as WriteSVG(): the fills become paths, and the quads of the font atlas
+// and of other textures become images with a soft mask.
+// Draw data that is not valid results in an empty page.
+func (exporter *Exporter) WritePDF(w io.Writer, data imgui.DrawData) error {
+	model := exporter.build(data)
+	writer := pdfWriter{
+		exporter: exporter,
+		alphas:   make(map[uint8]string),
+		images:   make(map[pdfImageKey]string),
+		masks:    make(map[textureSource]int),
+		// catalog, pages, page and content come first.
+		nextObject: 5,
+	}
+	fmt.Fprintf(&writer.content, "1 0 0 -1 0 %s cm\n", number(model.height))
+	for _, g := range model.groups {
+		writer.group(g)
+	}
+	return writer.write(w, model)
+}
+
+// pdfImageKey identifies an image: a part of a texture, either with its own colors,
+// or filled with a color as a mask.
+type pdfImageKey struct {
+	textureSource
+	mask  bool
+	color color.RGBA
+}
+
+// pdfObject is an object that is written after the content, with the stream deflated.
+type pdfObject struct {
+	number     int
+	dictionary string
+	stream     []byte
+}
+
+type pdfWriter struct {
+	exporter *Exporter
+	content  bytes.Buffer
+
+	alphas     map[uint8]string
+	images     map[pdfImageKey]string
+	masks      map[textureSource]int
+	objects    []pdfObject
+	nextObject int
+}
+
+func (writer *pdfWriter) group(g group) {
+	fmt.Fprintf(&writer.content, "q %s %s %s %s re W n\n",
+		number(g.clip.minX), number(g.clip.minY), number(g.clip.width()), number(g.clip.height()))
+	for _, s := range g.shapes {
+		writer.content.WriteString("/" + writer.alpha(s.color.A) + " gs\n")
+		switch s.kind {
+		case shapeFill:
+			fmt.Fprintf(&writer.content, "%s %s %s rg\n",
+				number(float32(s.color.R)/255), number(float32(s.color.G)/255), number(float32(s.color.B)/255))
+			for i := 0; i+5 < len(s.triangles); i += 6 {
+				t := s.triangles[i : i+6]
+				fmt.Fprintf(&writer.content, "%s %s m %s %s l %s %s l h\n",
+					number(t[0]), number(t[1]), number(t[2]), number(t[3]), number(t[4]), number(t[5]))
+			}
+			writer.content.WriteString("f\n")
+		case shapeMask, shapeImage:
+			// images fill the unit square, with their first row at the top.
+			fmt.Fprintf(&writer.content, "q %s 0 0 %s %s %s cm /%s Do Q\n",
+				number(s.target.width()), number(-s.target.height()), number(s.target.minX), number(s.target.maxY), writer.image(s))
+		}
+	}
+	writer.content.WriteString("Q\n")
+}
+
+// alpha returns the name of the graphics state with the given constant alpha.
+func (writer *pdfWriter) alpha(alpha uint8) string {
+	if name, defined := writer.alphas[alpha]; defined {
+		return name
+	}
+	name := fmt.Sprintf("GS%d", alpha)
+	writer.alphas[alpha] = name
+	return name
+}
+
+// image returns the name of the image XObject of the shape, creating it on first use.
+func (writer *pdfWriter) image(s shape) string {
+	source := textureSource{texture: s.texture, source: s.source}
+	key := pdfImageKey{textureSource: source, mask: s.kind == shapeMask}
+	if key.mask {
+		key.color = color.RGBA{R: s.color.R, G: s.color.G, B: s.color.B, A: 0xFF}
+	}
+	if name, defined := writer.images[key]; defined {
+		return name
+	}
+
+	texture := writer.exporter.textures[s.texture]
+	width, height := s.source.Dx(), s.source.Dy()
+	rgb := make([]byte, 0, width*height*3)
+	for y := s.source.Min.Y; y < s.source.Max.Y; y++ {
+		for x := s.source.Min.X; x < s.source.Max.X; x++ {
+			if key.mask {
+				rgb = append(rgb, key.color.R, key.color.G, key.color.B)
+			} else {
+				texel := texture.NRGBAAt(x, y)
+				rgb = append(rgb, texel.R, texel.G, texel.B)
+			}
+		}
+	}
+
+	object := writer.object(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d "+
+		"/ColorSpace /DeviceRGB /BitsPerComponent 8 /SMask %d 0 R", width, height, writer.softMask(source)), rgb)
+	name := fmt.Sprintf("Im%d", object)
+	writer.images[key] = name
+	return name
+}
+
+// softMask returns the object number of the alpha of the part of the texture, creating it on first use.
+func (writer *pdfWriter) softMask(source textureSource) int {
+	if object, defined := writer.masks[source]; defined {
+		return object
+	}
+	texture := writer.exporter.textures[source.texture]
+	alpha := make([]byte, 0, source.source.Dx()*source.source.Dy())
+	for y := source.source.Min.Y; y < source.source.Max.Y; y++ {
+		for x := source.source.Min.X; x < source.source.Max.X; x++ {
+			alpha = append(alpha, texture.NRGBAAt(x, y).A)
+		}
+	}
+	object := writer.object(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d "+
+		"/ColorSpace /DeviceGray /BitsPerComponent 8", source.source.Dx(), source.source.Dy()), alpha)
+	writer.masks[source] = object
+	return object
+}
+
+// object adds an object with a stream, and returns its number.
+func (writer *pdfWriter) object(dictionary string, stream []byte) int {
+	object := writer.nextObject
+	writer.nextObject++
+	writer.objects = append(writer.objects, pdfObject{number: object, dictionary: dictionary, stream: stream})
+	return object
+}
+
+// write writes the document: the catalog, the page tree, the page, its content, and the images.
+func (writer *pdfWriter) write(w io.Writer, model *page) error {
+	var resources strings.Builder
+	resources.WriteString("/ExtGState <<")
+	alphas := make([]int, 0, len(writer.alphas))
+	for alpha := range writer.alphas {
+		alphas = append(alphas, int(alpha))
+	}
+	sort.Ints(alphas)
+	for _, alpha := range alphas {
+		value := number(float32(alpha) / 255)
+		fmt.Fprintf(&resources, " /%s << /ca %s /CA %s >>", writer.alphas[uint8(alpha)], value, value)
+	}
+	resources.WriteString(" >> /XObject <<")
+	images := make([]string, 0, len(writer.images))
+	for _, name := range writer.images {
+		images = append(images, name)
+	}
+	sort.Strings(images)
+	for _, name := range images {
+		fmt.Fprintf(&resources, " /%s %s 0 R", name, strings.TrimPrefix(name, "Im"))
+	}
+	resources.WriteString(" >>")
+
+	objects := []pdfObject{
+		{number: 1, dictionary: "/Type /Catalog /Pages 2 0 R"},
+		{number: 2, dictionary: "/Type /Pages /Kids [3 0 R] /Count 1"},
+		{number: 3, dictionary: fmt.Sprintf("/Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << %s >> /Contents 4 0 R",
+			number(model.width), number(model.height), resources.String())},
+		{number: 4, stream: writer.content.Bytes()},
+	}
+	objects = append(objects, writer.objects...)
+
+	out := &countingWriter{w: w}
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int64, len(objects)+1)
+	for _, object := range objects {
+		offsets[object.number] = out.count
+		fmt.Fprintf(out, "%d 0 obj\n", object.number)
+		if object.stream == nil {
+			fmt.Fprintf(out, "<< %s >>\nendobj\n", object.dictionary)
+			continue
+		}
+		var deflated bytes.Buffer
+		compressor := zlib.NewWriter(&deflated)
+		compressor.Write(object.stream)
+		compressor.Close()
+		dictionary := object.dictionary
+		if dictionary != "" {
+			dictionary += " "
+		}
+		fmt.Fprintf(out, "<< %s/Filter /FlateDecode /Length %d >>\nstream\n", dictionary, deflated.Len())
+		out.Write(deflated.Bytes())
+		out.WriteString("\nendstream\nendobj\n")
+	}
+
+	xref := out.count
+	fmt.Fprintf(out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets))
+	for _, offset := range offsets[1:] {
+		fmt.Fprintf(out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets), xref)
+	return out.err
+}
+
+// countingWriter counts the bytes written, for the offsets of the cross-reference table,
+// and keeps the first error.
+type countingWriter struct {
+	w     io.Writer
+	count int64
+	err   error
+}
+
+func (counter *countingWriter) Write(p []byte) (int, error) {
+	if counter.err != nil {
+		return 0, counter.err
+	}
+	n, err := counter.w.Write(p)
+	counter.count += int64(n)
+	counter.err = err
+	return n, err
+}
+
+func (counter *countingWriter) WriteString(s string) {
+	_, _ = counter.Write([]byte(s))
+}
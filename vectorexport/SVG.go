@@ -0,0 +1,162 @@
+package vectorexport
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/jetsetilly/imgui-go/v5"
+)
+
+// WriteSVG writes the draw data as an SVG document, the size of the display.
+// Draw data that is not valid results in an empty document.
+func (exporter *Exporter) WriteSVG(w io.Writer, data imgui.DrawData) error {
+	model := exporter.build(data)
+	writer := svgWriter{
+		exporter: exporter,
+		clips:    make(map[rect]string),
+		masks:    make(map[textureSource]string),
+		patterns: make(map[textureSource]string),
+	}
+	for _, g := range model.groups {
+		writer.group(g)
+	}
+
+	out := bufio.NewWriter(w)
+	fmt.Fprintf(out, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s">`+"\n",
+		number(model.width), number(model.height), number(model.width), number(model.height))
+	if writer.defs.Len() > 0 {
+		out.WriteString("<defs>\n")
+		out.Write(writer.defs.Bytes())
+		out.WriteString("</defs>\n")
+	}
+	out.Write(writer.body.Bytes())
+	out.WriteString("</svg>\n")
+	return out.Flush()
+}
+
+// textureSource identifies a part of a texture, which is embedded only once.
+type textureSource struct {
+	texture imgui.TextureID
+	source  image.Rectangle
+}
+
+type svgWriter struct {
+	exporter *Exporter
+	defs     bytes.Buffer
+	body     bytes.Buffer
+
+	clips    map[rect]string
+	masks    map[textureSource]string
+	patterns map[textureSource]string
+}
+
+func (writer *svgWriter) group(g group) {
+	id, defined := writer.clips[g.clip]
+	if !defined {
+		id = "clip" + strconv.Itoa(len(writer.clips))
+		writer.clips[g.clip] = id
+		fmt.Fprintf(&writer.defs, `<clipPath id="%s"><rect x="%s" y="%s" width="%s" height="%s"/></clipPath>`+"\n",
+			id, number(g.clip.minX), number(g.clip.minY), number(g.clip.width()), number(g.clip.height()))
+	}
+	fmt.Fprintf(&writer.body, `<g clip-path="url(#%s)">`+"\n", id)
+	for _, s := range g.shapes {
+		switch s.kind {
+		case shapeFill:
+			writer.body.WriteString(`<path d="`)
+			for i := 0; i+5 < len(s.triangles); i += 6 {
+				if i > 0 {
+					writer.body.WriteByte(' ')
+				}
+				t := s.triangles[i : i+6]
+				fmt.Fprintf(&writer.body, "M%s %sL%s %sL%s %sZ",
+					number(t[0]), number(t[1]), number(t[2]), number(t[3]), number(t[4]), number(t[5]))
+			}
+			fmt.Fprintf(&writer.body, `"%s/>`+"\n", fillAttributes(s.color))
+		case shapeMask:
+			fmt.Fprintf(&writer.body, `<rect %s%s mask="url(#%s)"/>`+"\n",
+				rectAttributes(s.target), fillAttributes(s.color), writer.mask(s))
+		case shapeImage:
+			fmt.Fprintf(&writer.body, `<rect %s fill="url(#%s)"%s/>`+"\n",
+				rectAttributes(s.target), writer.pattern(s), opacityAttribute("fill-opacity", s.color.A))
+		}
+	}
+	writer.body.WriteString("</g>\n")
+}
+
+// mask returns the ID of the mask with the alpha of the part of the texture, defining it on first use.
+// The mask stretches over the bounding box of the element it is applied to.
+func (writer *svgWriter) mask(s shape) string {
+	key := textureSource{texture: s.texture, source: s.source}
+	if id, defined := writer.masks[key]; defined {
+		return id
+	}
+	id := "mask" + strconv.Itoa(len(writer.masks))
+	writer.masks[key] = id
+	alpha := alphaImage(writer.exporter.textures[s.texture], s.source)
+	fmt.Fprintf(&writer.defs, `<mask id="%s" maskContentUnits="objectBoundingBox">`+
+		`<image width="1" height="1" preserveAspectRatio="none" href="%s"/></mask>`+"\n", id, dataURL(alpha))
+	return id
+}
+
+// pattern returns the ID of the pattern with the part of the texture, defining it on first use.
+// The pattern stretches over the bounding box of the element it fills.
+func (writer *svgWriter) pattern(s shape) string {
+	key := textureSource{texture: s.texture, source: s.source}
+	if id, defined := writer.patterns[key]; defined {
+		return id
+	}
+	id := "image" + strconv.Itoa(len(writer.patterns))
+	writer.patterns[key] = id
+	texture := writer.exporter.textures[s.texture]
+	fmt.Fprintf(&writer.defs, `<pattern id="%s" width="1" height="1" patternContentUnits="objectBoundingBox">`+
+		`<image width="1" height="1" preserveAspectRatio="none" href="%s"/></pattern>`+"\n",
+		id, dataURL(texture.SubImage(s.source)))
+	return id
+}
+
+// alphaImage returns the part of the texture as white, with the alpha of the texture.
+func alphaImage(texture *image.NRGBA, source image.Rectangle) *image.NRGBA {
+	alpha := image.NewNRGBA(image.Rect(0, 0, source.Dx(), source.Dy()))
+	for y := 0; y < source.Dy(); y++ {
+		for x := 0; x < source.Dx(); x++ {
+			a := texture.NRGBAAt(source.Min.X+x, source.Min.Y+y).A
+			alpha.SetNRGBA(x, y, color.NRGBA{R: 0xFF, G: 0xFF, B: 0xFF, A: a})
+		}
+	}
+	return alpha
+}
+
+func dataURL(img image.Image) string {
+	var encoded bytes.Buffer
+	_ = png.Encode(&encoded, img)
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(encoded.Bytes())
+}
+
+func rectAttributes(r rect) string {
+	return fmt.Sprintf(`x="%s" y="%s" width="%s" height="%s"`, number(r.minX), number(r.minY), number(r.width()), number(r.height()))
+}
+
+func fillAttributes(clr color.NRGBA) string {
+	return fmt.Sprintf(` fill="#%02x%02x%02x"`, clr.R, clr.G, clr.B) + opacityAttribute("fill-opacity", clr.A)
+}
+
+func opacityAttribute(name string, alpha uint8) string {
+	if alpha == 0xFF {
+		return ""
+	}
+	return fmt.Sprintf(` %s="%s"`, name, number(float32(alpha)/255))
+}
+
+// number formats a coordinate with at most three decimals, which is exact for the positions
+// ImGui usually produces, and far below the resolution of any output device.
+func number(value float32) string {
+	return strconv.FormatFloat(math.Round(float64(value)*1000)/1000, 'f', -1, 64)
+}
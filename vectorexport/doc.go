@@ -0,0 +1,29 @@
+// Package vectorexport converts imgui.DrawData into vector graphics, as SVG or PDF documents.
+//
+// The exporter walks the command lists of a rendered frame, like a renderer does, and builds a path model:
+//
+//   - Consecutive triangles of a single color are merged into one path. Their color is the average
+//     of their vertex colors, multiplied by the texel at their center; for the solid shapes of ImGui,
+//     which sample the white texel of the font atlas, this is exact. The anti-aliased fringes of ImGui
+//     become thin, translucent paths.
+//   - Rectangles that show a part of the font atlas, such as glyphs, become that part as a mask,
+//     filled with the color of the rectangle. Each part is embedded only once.
+//   - Rectangles that show a part of another texture become an image of that part, with the alpha of
+//     the vertex color. Other tints are not applied.
+//   - The clip rectangle of every draw command becomes a clip path.
+//
+// Textures are taken from images registered with the exporter, as with the softrender package:
+// the font atlas with RegisterFontAtlas(), all other textures with RegisterTexture().
+// Draw commands with unregistered textures, and commands with user callbacks, are left out.
+//
+// Coordinates are display units, relative to the display position of the frame. In a PDF,
+// a display unit is one point.
+//
+//	exporter := vectorexport.NewExporter()
+//	exporter.RegisterFontAtlas(imgui.CurrentIO().Fonts())
+//
+//	imgui.NewFrame()
+//	// ...
+//	imgui.Render()
+//	err := exporter.WriteSVG(file, imgui.RenderedDrawData())
+package vectorexport
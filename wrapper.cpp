@@ -12,21 +12,27 @@
 #endif
 
 // imgui-go code
+#include "wrapper/Accessibility.cpp"
 #include "wrapper/Color.cpp"
 #include "wrapper/Context.cpp"
 #include "wrapper/Focus.cpp"
+#include "wrapper/DockBuilder.cpp"
+#include "wrapper/Docking.cpp"
 #include "wrapper/DragDrop.cpp"
 #include "wrapper/DrawCommand.cpp"
 #include "wrapper/DrawData.cpp"
 #include "wrapper/DrawList.cpp"
+#include "wrapper/DrawListSplitter.cpp"
 #include "wrapper/Font.cpp"
 #include "wrapper/FontAtlas.cpp"
 #include "wrapper/FontConfig.cpp"
 #include "wrapper/InputTextCallbackData.cpp"
 #include "wrapper/IO.cpp"
+#include "wrapper/ItemRegistry.cpp"
 #include "wrapper/Layout.cpp"
 #include "wrapper/ListClipper.cpp"
 #include "wrapper/Main.cpp"
+#include "wrapper/MultiSelect.cpp"
 #include "wrapper/PlatformIO.cpp"
 #include "wrapper/Popup.cpp"
 #include "wrapper/Scroll.cpp"
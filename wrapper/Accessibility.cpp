@@ -0,0 +1,46 @@
+#include "ConfiguredImGui.h"
+#include "imgui_internal.h"
+
+#include "Accessibility.h"
+#include "WrapperConverter.h"
+
+static void iggSetAccessibleElement(IggAccessibleElement *out, ImGuiID id, ImRect const &rect, bool disabled)
+{
+   out->ID = id;
+   out->Name = nullptr;
+   exportValue(out->Min, rect.Min);
+   exportValue(out->Max, rect.Max);
+   out->Disabled = disabled ? 1 : 0;
+}
+
+void iggAccessibleLastItem(IggAccessibleElement *out)
+{
+   ImGuiContext &g = *GImGui;
+   iggSetAccessibleElement(out, g.LastItemData.ID, g.LastItemData.Rect, (g.LastItemData.ItemFlags & ImGuiItemFlags_Disabled) != 0);
+}
+
+void iggAccessibleCurrentWindow(IggAccessibleElement *out)
+{
+   ImGuiContext &g = *GImGui;
+   ImGuiWindow *window = g.CurrentWindow;
+   iggSetAccessibleElement(out, window->ID, window->Rect(), (g.CurrentItemFlags & ImGuiItemFlags_Disabled) != 0);
+   out->Name = window->Name;
+}
+
+int iggAccessibleTableRow(void)
+{
+   ImGuiTable *table = GImGui->CurrentTable;
+   return (table != nullptr) ? table->CurrentRow : -1;
+}
+
+IggBool iggAccessibleTableCell(int column, IggAccessibleElement *out)
+{
+   ImGuiContext &g = *GImGui;
+   ImGuiTable *table = g.CurrentTable;
+   if ((table == nullptr) || (column < 0) || (column >= table->ColumnsCount))
+   {
+      return 0;
+   }
+   iggSetAccessibleElement(out, 0, ImGui::TableGetCellBgRect(table, column), (g.CurrentItemFlags & ImGuiItemFlags_Disabled) != 0);
+   return 1;
+}
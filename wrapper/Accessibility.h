@@ -0,0 +1,25 @@
+#pragma once
+
+#include "Types.h"
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+typedef struct tagIggAccessibleElement
+{
+   unsigned int ID;
+   char const *Name;
+   IggVec2 Min;
+   IggVec2 Max;
+   IggBool Disabled;
+} IggAccessibleElement;
+
+extern void iggAccessibleLastItem(IggAccessibleElement *out);
+extern void iggAccessibleCurrentWindow(IggAccessibleElement *out);
+extern int iggAccessibleTableRow(void);
+extern IggBool iggAccessibleTableCell(int column, IggAccessibleElement *out);
+
+#ifdef __cplusplus
+}
+#endif
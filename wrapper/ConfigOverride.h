@@ -2,14 +2,20 @@
 
 // Overrides as per standard imconfig.h
 
-/* extern "C" void iggAssert(char const *expression, char const *file, int line); */
-/* #define IM_ASSERT(_EXPR)                        \ */
-/*    do                                           \ */
-/*    {                                            \ */
-/*       if ((_EXPR) == 0)                         \ */
-/*       {                                         \ */
-/*          iggAssert(#_EXPR, __FILE__, __LINE__); \ */
-/*       }                                         \ */
-/*    } while (false) */
+// The declaration must match the one generated for the exported Go function in _cgo_export.h,
+// hence the non-const parameters.
+extern "C" void iggAssert(char *expression, char *file, int line);
+#define IM_ASSERT(_EXPR)                                                             \
+   do                                                                                \
+   {                                                                                 \
+      if ((_EXPR) == 0)                                                              \
+      {                                                                              \
+         iggAssert(const_cast<char *>(#_EXPR), const_cast<char *>(__FILE__), __LINE__); \
+      }                                                                              \
+   } while (false)
 
 #define IMGUI_DISABLE_OBSOLETE_FUNCTIONS
+
+// The hooks of the test engine are implemented in ItemRegistry.cpp.
+// They are only called while the item registry of a context is enabled.
+#define IMGUI_ENABLE_TEST_ENGINE
@@ -0,0 +1,69 @@
+#include "ConfiguredImGui.h"
+#include "imgui_internal.h"
+
+#include "DockBuilder.h"
+#include "WrapperConverter.h"
+
+IggBool iggDockBuilderNodeExists(unsigned int nodeId)
+{
+   return (ImGui::DockBuilderGetNode(nodeId) != NULL) ? 1 : 0;
+}
+
+unsigned int iggDockBuilderGetCentralNode(unsigned int nodeId)
+{
+   ImGuiDockNode *node = ImGui::DockBuilderGetCentralNode(nodeId);
+   return (node != NULL) ? node->ID : 0;
+}
+
+unsigned int iggDockBuilderAddNode(unsigned int nodeId, int flags)
+{
+   return ImGui::DockBuilderAddNode(nodeId, flags);
+}
+
+void iggDockBuilderRemoveNode(unsigned int nodeId)
+{
+   ImGui::DockBuilderRemoveNode(nodeId);
+}
+
+void iggDockBuilderRemoveNodeDockedWindows(unsigned int nodeId, IggBool clearSettingsRefs)
+{
+   ImGui::DockBuilderRemoveNodeDockedWindows(nodeId, clearSettingsRefs != 0);
+}
+
+void iggDockBuilderRemoveNodeChildNodes(unsigned int nodeId)
+{
+   ImGui::DockBuilderRemoveNodeChildNodes(nodeId);
+}
+
+void iggDockBuilderSetNodePos(unsigned int nodeId, IggVec2 const *pos)
+{
+   Vec2Wrapper posArg(pos);
+   ImGui::DockBuilderSetNodePos(nodeId, *posArg);
+}
+
+void iggDockBuilderSetNodeSize(unsigned int nodeId, IggVec2 const *size)
+{
+   Vec2Wrapper sizeArg(size);
+   ImGui::DockBuilderSetNodeSize(nodeId, *sizeArg);
+}
+
+unsigned int iggDockBuilderSplitNode(unsigned int nodeId, IggDir splitDir, float sizeRatioForNodeAtDir,
+                                     unsigned int *outIdAtDir, unsigned int *outIdAtOppositeDir)
+{
+   ImGuiID idAtDir = 0;
+   ImGuiID idAtOppositeDir = 0;
+   ImGuiID result = ImGui::DockBuilderSplitNode(nodeId, static_cast<ImGuiDir>(splitDir), sizeRatioForNodeAtDir, &idAtDir, &idAtOppositeDir);
+   *outIdAtDir = idAtDir;
+   *outIdAtOppositeDir = idAtOppositeDir;
+   return result;
+}
+
+void iggDockBuilderDockWindow(char const *windowName, unsigned int nodeId)
+{
+   ImGui::DockBuilderDockWindow(windowName, nodeId);
+}
+
+void iggDockBuilderFinish(unsigned int nodeId)
+{
+   ImGui::DockBuilderFinish(nodeId);
+}
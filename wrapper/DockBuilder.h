@@ -0,0 +1,24 @@
+#pragma once
+
+#include "Types.h"
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+extern IggBool iggDockBuilderNodeExists(unsigned int nodeId);
+extern unsigned int iggDockBuilderGetCentralNode(unsigned int nodeId);
+extern unsigned int iggDockBuilderAddNode(unsigned int nodeId, int flags);
+extern void iggDockBuilderRemoveNode(unsigned int nodeId);
+extern void iggDockBuilderRemoveNodeDockedWindows(unsigned int nodeId, IggBool clearSettingsRefs);
+extern void iggDockBuilderRemoveNodeChildNodes(unsigned int nodeId);
+extern void iggDockBuilderSetNodePos(unsigned int nodeId, IggVec2 const *pos);
+extern void iggDockBuilderSetNodeSize(unsigned int nodeId, IggVec2 const *size);
+extern unsigned int iggDockBuilderSplitNode(unsigned int nodeId, IggDir splitDir, float sizeRatioForNodeAtDir,
+                                            unsigned int *outIdAtDir, unsigned int *outIdAtOppositeDir);
+extern void iggDockBuilderDockWindow(char const *windowName, unsigned int nodeId);
+extern void iggDockBuilderFinish(unsigned int nodeId);
+
+#ifdef __cplusplus
+}
+#endif
@@ -0,0 +1,63 @@
+#include "ConfiguredImGui.h"
+
+#include "Docking.h"
+#include "WrapperConverter.h"
+
+static void iggWindowClassFrom(ImGuiWindowClass &out, IggWindowClass const *windowClass)
+{
+   out.ClassId = windowClass->ClassId;
+   out.ParentViewportId = windowClass->ParentViewportId;
+   out.FocusRouteParentWindowId = windowClass->FocusRouteParentWindowId;
+   out.ViewportFlagsOverrideSet = windowClass->ViewportFlagsOverrideSet;
+   out.ViewportFlagsOverrideClear = windowClass->ViewportFlagsOverrideClear;
+   out.TabItemFlagsOverrideSet = windowClass->TabItemFlagsOverrideSet;
+   out.DockNodeFlagsOverrideSet = windowClass->DockNodeFlagsOverrideSet;
+   out.DockingAlwaysTabBar = windowClass->DockingAlwaysTabBar != 0;
+   out.DockingAllowUnclassed = windowClass->DockingAllowUnclassed != 0;
+}
+
+unsigned int iggDockSpace(unsigned int id, IggVec2 const *size, int flags, IggWindowClass const *windowClass)
+{
+   Vec2Wrapper sizeArg(size);
+   if (windowClass == NULL)
+   {
+      return ImGui::DockSpace(id, *sizeArg, flags);
+   }
+   ImGuiWindowClass windowClassArg;
+   iggWindowClassFrom(windowClassArg, windowClass);
+   return ImGui::DockSpace(id, *sizeArg, flags, &windowClassArg);
+}
+
+unsigned int iggDockSpaceOverViewport(unsigned int id, IggViewport viewport, int flags, IggWindowClass const *windowClass)
+{
+   ImGuiViewport const *viewportArg = reinterpret_cast<ImGuiViewport const *>(viewport);
+   if (windowClass == NULL)
+   {
+      return ImGui::DockSpaceOverViewport(id, viewportArg, flags);
+   }
+   ImGuiWindowClass windowClassArg;
+   iggWindowClassFrom(windowClassArg, windowClass);
+   return ImGui::DockSpaceOverViewport(id, viewportArg, flags, &windowClassArg);
+}
+
+void iggSetNextWindowDockID(unsigned int id, int cond)
+{
+   ImGui::SetNextWindowDockID(id, cond);
+}
+
+void iggSetNextWindowClass(IggWindowClass const *windowClass)
+{
+   ImGuiWindowClass windowClassArg;
+   iggWindowClassFrom(windowClassArg, windowClass);
+   ImGui::SetNextWindowClass(&windowClassArg);
+}
+
+unsigned int iggGetWindowDockID(void)
+{
+   return ImGui::GetWindowDockID();
+}
+
+IggBool iggIsWindowDocked(void)
+{
+   return ImGui::IsWindowDocked() ? 1 : 0;
+}
@@ -0,0 +1,31 @@
+#pragma once
+
+#include "Types.h"
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+typedef struct tagIggWindowClass
+{
+   unsigned int ClassId;
+   unsigned int ParentViewportId;
+   unsigned int FocusRouteParentWindowId;
+   int          ViewportFlagsOverrideSet;
+   int          ViewportFlagsOverrideClear;
+   int          TabItemFlagsOverrideSet;
+   int          DockNodeFlagsOverrideSet;
+   IggBool      DockingAlwaysTabBar;
+   IggBool      DockingAllowUnclassed;
+} IggWindowClass;
+
+extern unsigned int iggDockSpace(unsigned int id, IggVec2 const *size, int flags, IggWindowClass const *windowClass);
+extern unsigned int iggDockSpaceOverViewport(unsigned int id, IggViewport viewport, int flags, IggWindowClass const *windowClass);
+extern void iggSetNextWindowDockID(unsigned int id, int cond);
+extern void iggSetNextWindowClass(IggWindowClass const *windowClass);
+extern unsigned int iggGetWindowDockID(void);
+extern IggBool iggIsWindowDocked(void);
+
+#ifdef __cplusplus
+}
+#endif
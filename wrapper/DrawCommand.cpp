@@ -2,6 +2,7 @@
 
 #include "DrawCommand.h"
 #include "WrapperConverter.h"
+#include "_cgo_export.h"
 
 void iggDrawCommandGetElementCount(IggDrawCmd handle, unsigned int *count)
 {
@@ -39,9 +40,37 @@ IggBool iggDrawCommandHasUserCallback(IggDrawCmd handle)
    return (cmd->UserCallback != 0) ? 1 : 0;
 }
 
+IggBool iggDrawCommandIsResetRenderState(IggDrawCmd handle)
+{
+   ImDrawCmd *cmd = reinterpret_cast<ImDrawCmd *>(handle);
+   return (cmd->UserCallback == ImDrawCallback_ResetRenderState) ? 1 : 0;
+}
+
 void iggDrawCommandCallUserCallback(IggDrawCmd handle, IggDrawList listHandle)
 {
    ImDrawCmd *cmd = reinterpret_cast<ImDrawCmd *>(handle);
    ImDrawList *list = reinterpret_cast<ImDrawList *>(listHandle);
+   if ((cmd->UserCallback == 0) || (cmd->UserCallback == ImDrawCallback_ResetRenderState))
+   {
+      return;
+   }
    cmd->UserCallback(list, cmd);
 }
+
+IggBool iggDrawCommandGetGoCallback(IggDrawCmd handle, uintptr_t *callback)
+{
+   ImDrawCmd *cmd = reinterpret_cast<ImDrawCmd *>(handle);
+   if (cmd->UserCallback != iggDrawCommandGoCallback)
+   {
+      return 0;
+   }
+   *callback = reinterpret_cast<uintptr_t>(cmd->UserCallbackData);
+   return 1;
+}
+
+void iggDrawCommandGoCallback(ImDrawList const *list, ImDrawCmd const *cmd)
+{
+   iggDrawListCallback(reinterpret_cast<uintptr_t>(cmd->UserCallbackData),
+      static_cast<IggDrawList>(const_cast<ImDrawList *>(list)),
+      static_cast<IggDrawCmd>(const_cast<ImDrawCmd *>(cmd)));
+}
@@ -12,8 +12,17 @@ extern void iggDrawCommandGetVertexOffset(IggDrawCmd handle, unsigned int *count
 extern void iggDrawCommandGetClipRect(IggDrawCmd handle, IggVec4 *rect);
 extern void iggDrawCommandGetTextureID(IggDrawCmd handle, IggTextureID *id);
 extern IggBool iggDrawCommandHasUserCallback(IggDrawCmd handle);
+extern IggBool iggDrawCommandIsResetRenderState(IggDrawCmd handle);
 extern void iggDrawCommandCallUserCallback(IggDrawCmd handle, IggDrawList listHandle);
+extern IggBool iggDrawCommandGetGoCallback(IggDrawCmd handle, uintptr_t *callback);
 
 #ifdef __cplusplus
 }
+
+struct ImDrawList;
+struct ImDrawCmd;
+
+// iggDrawCommandGoCallback is the draw callback of all callbacks added from Go.
+// The user data of the command is the handle of the Go function.
+extern void iggDrawCommandGoCallback(ImDrawList const *list, ImDrawCmd const *cmd);
 #endif
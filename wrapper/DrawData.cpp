@@ -47,3 +47,65 @@ void iggDrawDataScaleClipRects(IggDrawData handle, IggVec2 const *scale)
    Vec2Wrapper wrappedScale(scale);
    drawData->ScaleClipRects(*wrappedScale);
 }
+
+IggDrawData iggDrawDataNew(IggBool valid, IggVec2 const *displayPos, IggVec2 const *displaySize, IggVec2 const *frameBufferScale)
+{
+   ImDrawData *drawData = IM_NEW(ImDrawData)();
+   Vec2Wrapper wrappedDisplayPos(displayPos);
+   Vec2Wrapper wrappedDisplaySize(displaySize);
+   Vec2Wrapper wrappedFrameBufferScale(frameBufferScale);
+   drawData->Valid = valid != 0;
+   drawData->DisplayPos = *wrappedDisplayPos;
+   drawData->DisplaySize = *wrappedDisplaySize;
+   drawData->FramebufferScale = *wrappedFrameBufferScale;
+   return reinterpret_cast<IggDrawData>(drawData);
+}
+
+void iggDrawDataDelete(IggDrawData handle)
+{
+   ImDrawData *drawData = reinterpret_cast<ImDrawData *>(handle);
+   for (ImDrawList *list : drawData->CmdLists)
+   {
+      IM_DELETE(list);
+   }
+   IM_DELETE(drawData);
+}
+
+IggDrawList iggDrawDataAddNewList(IggDrawData handle, void const *vertices, int vertexCount, void const *indices, int indexCount)
+{
+   ImDrawData *drawData = reinterpret_cast<ImDrawData *>(handle);
+   ImDrawList *list = IM_NEW(ImDrawList)(nullptr);
+   list->VtxBuffer.resize(vertexCount);
+   if (vertexCount > 0)
+   {
+      memcpy(list->VtxBuffer.Data, vertices, vertexCount * sizeof(ImDrawVert));
+   }
+   list->IdxBuffer.resize(indexCount);
+   if (indexCount > 0)
+   {
+      memcpy(list->IdxBuffer.Data, indices, indexCount * sizeof(ImDrawIdx));
+   }
+   drawData->CmdLists.push_back(list);
+   drawData->CmdListsCount++;
+   drawData->TotalVtxCount += vertexCount;
+   drawData->TotalIdxCount += indexCount;
+   return reinterpret_cast<IggDrawList>(list);
+}
+
+void iggDrawDataListAddCommand(IggDrawList listHandle, unsigned int elementCount, unsigned int indexOffset, unsigned int vertexOffset,
+                               IggVec4 const *clipRect, IggTextureID textureID, IggBool resetRenderState)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(listHandle);
+   Vec4Wrapper wrappedClipRect(clipRect);
+   ImDrawCmd cmd;
+   cmd.ElemCount = elementCount;
+   cmd.IdxOffset = indexOffset;
+   cmd.VtxOffset = vertexOffset;
+   cmd.ClipRect = *wrappedClipRect;
+   cmd.TextureId = static_cast<ImTextureID>(textureID);
+   if (resetRenderState != 0)
+   {
+      cmd.UserCallback = ImDrawCallback_ResetRenderState;
+   }
+   list->CmdBuffer.push_back(cmd);
+}
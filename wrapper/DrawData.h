@@ -14,6 +14,12 @@ extern void iggDrawDataDisplaySize(IggDrawData handle, IggVec2 *value);
 extern void iggDrawDataFrameBufferScale(IggDrawData handle, IggVec2 *value);
 extern void iggDrawDataScaleClipRects(IggDrawData handle, IggVec2 const *scale);
 
+extern IggDrawData iggDrawDataNew(IggBool valid, IggVec2 const *displayPos, IggVec2 const *displaySize, IggVec2 const *frameBufferScale);
+extern void iggDrawDataDelete(IggDrawData handle);
+extern IggDrawList iggDrawDataAddNewList(IggDrawData handle, void const *vertices, int vertexCount, void const *indices, int indexCount);
+extern void iggDrawDataListAddCommand(IggDrawList listHandle, unsigned int elementCount, unsigned int indexOffset, unsigned int vertexOffset,
+                                      IggVec4 const *clipRect, IggTextureID textureID, IggBool resetRenderState);
+
 #ifdef __cplusplus
 }
 #endif
@@ -1,5 +1,6 @@
 #include "ConfiguredImGui.h"
 
+#include "DrawCommand.h"
 #include "DrawList.h"
 #include "WrapperConverter.h"
 
@@ -114,6 +115,105 @@ void iggAddTriangleFilled(IggDrawList handle, IggVec2 *p1, IggVec2 *p2, IggVec2
    list->AddTriangleFilled(*p1Arg, *p2Arg, *p3Arg, col);
 }
 
+void iggAddQuad(IggDrawList handle, IggVec2 const *p1, IggVec2 const *p2, IggVec2 const *p3, IggVec2 const *p4, IggPackedColor col, float thickness)
+{
+   Vec2Wrapper p1Arg(p1);
+   Vec2Wrapper p2Arg(p2);
+   Vec2Wrapper p3Arg(p3);
+   Vec2Wrapper p4Arg(p4);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddQuad(*p1Arg, *p2Arg, *p3Arg, *p4Arg, col, thickness);
+}
+
+void iggAddQuadFilled(IggDrawList handle, IggVec2 const *p1, IggVec2 const *p2, IggVec2 const *p3, IggVec2 const *p4, IggPackedColor col)
+{
+   Vec2Wrapper p1Arg(p1);
+   Vec2Wrapper p2Arg(p2);
+   Vec2Wrapper p3Arg(p3);
+   Vec2Wrapper p4Arg(p4);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddQuadFilled(*p1Arg, *p2Arg, *p3Arg, *p4Arg, col);
+}
+
+void iggAddNgon(IggDrawList handle, IggVec2 const *center, float radius, IggPackedColor col, int numSegments, float thickness)
+{
+   Vec2Wrapper centerArg(center);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddNgon(*centerArg, radius, col, numSegments, thickness);
+}
+
+void iggAddNgonFilled(IggDrawList handle, IggVec2 const *center, float radius, IggPackedColor col, int numSegments)
+{
+   Vec2Wrapper centerArg(center);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddNgonFilled(*centerArg, radius, col, numSegments);
+}
+
+void iggAddEllipse(IggDrawList handle, IggVec2 const *center, IggVec2 const *radius, IggPackedColor col, float rot, int numSegments, float thickness)
+{
+   Vec2Wrapper centerArg(center);
+   Vec2Wrapper radiusArg(radius);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddEllipse(*centerArg, *radiusArg, col, rot, numSegments, thickness);
+}
+
+void iggAddEllipseFilled(IggDrawList handle, IggVec2 const *center, IggVec2 const *radius, IggPackedColor col, float rot, int numSegments)
+{
+   Vec2Wrapper centerArg(center);
+   Vec2Wrapper radiusArg(radius);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddEllipseFilled(*centerArg, *radiusArg, col, rot, numSegments);
+}
+
+void iggAddBezierCubic(IggDrawList handle, IggVec2 const *p1, IggVec2 const *p2, IggVec2 const *p3, IggVec2 const *p4, IggPackedColor col, float thickness, int numSegments)
+{
+   Vec2Wrapper p1Arg(p1);
+   Vec2Wrapper p2Arg(p2);
+   Vec2Wrapper p3Arg(p3);
+   Vec2Wrapper p4Arg(p4);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddBezierCubic(*p1Arg, *p2Arg, *p3Arg, *p4Arg, col, thickness, numSegments);
+}
+
+void iggAddBezierQuadratic(IggDrawList handle, IggVec2 const *p1, IggVec2 const *p2, IggVec2 const *p3, IggPackedColor col, float thickness, int numSegments)
+{
+   Vec2Wrapper p1Arg(p1);
+   Vec2Wrapper p2Arg(p2);
+   Vec2Wrapper p3Arg(p3);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddBezierQuadratic(*p1Arg, *p2Arg, *p3Arg, col, thickness, numSegments);
+}
+
+// The points are passed as an array of IggVec2, which has the same layout as ImVec2.
+// This saves a conversion per point for long polylines.
+static_assert(sizeof(IggVec2) == sizeof(ImVec2), "IggVec2 must have the layout of ImVec2");
+
+void iggAddPolyline(IggDrawList handle, IggVec2 const *points, int count, IggPackedColor col, int flags, float thickness)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddPolyline(reinterpret_cast<ImVec2 const *>(points), count, col, flags, thickness);
+}
+
+void iggAddConvexPolyFilled(IggDrawList handle, IggVec2 const *points, int count, IggPackedColor col)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddConvexPolyFilled(reinterpret_cast<ImVec2 const *>(points), count, col);
+}
+
+void iggAddConcavePolyFilled(IggDrawList handle, IggVec2 const *points, int count, IggPackedColor col)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddConcavePolyFilled(reinterpret_cast<ImVec2 const *>(points), count, col);
+}
+
 void iggAddText(IggDrawList handle, IggVec2 const *pos, IggPackedColor col, const char *text, int length)
 {
    Vec2Wrapper posArg(pos);
@@ -145,6 +245,114 @@ void iggAddImageQuad(IggDrawList handle, IggTextureID textureID, IggVec2* p1, Ig
   list->AddImageQuad(reinterpret_cast<ImTextureID>(textureID), *p1Arg, *p2Arg, *p3Arg, *p4Arg, *uv1Arg, *uv2Arg, *uv3Arg, *uv4Arg, col);
 }
 
+void iggAddImageRounded(IggDrawList handle, IggTextureID textureID, IggVec2 const *pMin, IggVec2 const *pMax, IggVec2 const *uvMin, IggVec2 const *uvMax, IggPackedColor col, float rounding, int flags)
+{
+   Vec2Wrapper pMinArg(pMin);
+   Vec2Wrapper pMaxArg(pMax);
+   Vec2Wrapper uvMinArg(uvMin);
+   Vec2Wrapper uvMaxArg(uvMax);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddImageRounded(reinterpret_cast<ImTextureID>(textureID), *pMinArg, *pMaxArg, *uvMinArg, *uvMaxArg, col, rounding, flags);
+}
+
+void iggAddCallback(IggDrawList handle, uintptr_t callback)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddCallback(iggDrawCommandGoCallback, reinterpret_cast<void *>(callback));
+}
+
+void iggAddResetRenderState(IggDrawList handle)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->AddCallback(ImDrawCallback_ResetRenderState, NULL);
+}
+
+void iggPathClear(IggDrawList handle)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->PathClear();
+}
+
+void iggPathLineTo(IggDrawList handle, IggVec2 const *pos)
+{
+   Vec2Wrapper posArg(pos);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->PathLineTo(*posArg);
+}
+
+void iggPathFillConvex(IggDrawList handle, IggPackedColor col)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->PathFillConvex(col);
+}
+
+void iggPathFillConcave(IggDrawList handle, IggPackedColor col)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->PathFillConcave(col);
+}
+
+void iggPathStroke(IggDrawList handle, IggPackedColor col, int flags, float thickness)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->PathStroke(col, flags, thickness);
+}
+
+void iggPathArcTo(IggDrawList handle, IggVec2 const *center, float radius, float aMin, float aMax, int numSegments)
+{
+   Vec2Wrapper centerArg(center);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->PathArcTo(*centerArg, radius, aMin, aMax, numSegments);
+}
+
+void iggPathArcToFast(IggDrawList handle, IggVec2 const *center, float radius, int aMinOf12, int aMaxOf12)
+{
+   Vec2Wrapper centerArg(center);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->PathArcToFast(*centerArg, radius, aMinOf12, aMaxOf12);
+}
+
+void iggPathEllipticalArcTo(IggDrawList handle, IggVec2 const *center, IggVec2 const *radius, float rot, float aMin, float aMax, int numSegments)
+{
+   Vec2Wrapper centerArg(center);
+   Vec2Wrapper radiusArg(radius);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->PathEllipticalArcTo(*centerArg, *radiusArg, rot, aMin, aMax, numSegments);
+}
+
+void iggPathBezierCubicCurveTo(IggDrawList handle, IggVec2 const *p2, IggVec2 const *p3, IggVec2 const *p4, int numSegments)
+{
+   Vec2Wrapper p2Arg(p2);
+   Vec2Wrapper p3Arg(p3);
+   Vec2Wrapper p4Arg(p4);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->PathBezierCubicCurveTo(*p2Arg, *p3Arg, *p4Arg, numSegments);
+}
+
+void iggPathBezierQuadraticCurveTo(IggDrawList handle, IggVec2 const *p2, IggVec2 const *p3, int numSegments)
+{
+   Vec2Wrapper p2Arg(p2);
+   Vec2Wrapper p3Arg(p3);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->PathBezierQuadraticCurveTo(*p2Arg, *p3Arg, numSegments);
+}
+
+void iggPathRect(IggDrawList handle, IggVec2 const *rectMin, IggVec2 const *rectMax, float rounding, int flags)
+{
+   Vec2Wrapper rectMinArg(rectMin);
+   Vec2Wrapper rectMaxArg(rectMax);
+
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->PathRect(*rectMinArg, *rectMaxArg, rounding, flags);
+}
+
 void iggPushClipRect(IggDrawList handle, IggVec2 const *min, IggVec2 const *max, IggBool intersectWithCurrentClipRect)
 {
    ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
@@ -159,6 +367,24 @@ void iggPopClipRect(IggDrawList handle)
    list->PopClipRect();
 }
 
+void iggDrawListChannelsSplit(IggDrawList handle, int count)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->ChannelsSplit(count);
+}
+
+void iggDrawListChannelsMerge(IggDrawList handle)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->ChannelsMerge();
+}
+
+void iggDrawListChannelsSetCurrent(IggDrawList handle, int index)
+{
+   ImDrawList *list = reinterpret_cast<ImDrawList *>(handle);
+   list->ChannelsSetCurrent(index);
+}
+
 IggDrawList iggGetWindowDrawList()
 {
    return static_cast<IggDrawList>(const_cast<ImDrawList *>(ImGui::GetWindowDrawList()));
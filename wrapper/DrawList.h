@@ -22,13 +22,43 @@ extern void iggAddCircle(IggDrawList handle, IggVec2 const *center, float radius
 extern void iggAddCircleFilled(IggDrawList handle, IggVec2 const *center, float radius, IggPackedColor col, int numSegments);
 extern void iggAddTriangle(IggDrawList handle, IggVec2 *p1, IggVec2 *p2, IggVec2 *p3, IggPackedColor col, float thickness);
 extern void iggAddTriangleFilled(IggDrawList handle, IggVec2 *p1, IggVec2 *p2, IggVec2 *p3, IggPackedColor col);
+extern void iggAddQuad(IggDrawList handle, IggVec2 const *p1, IggVec2 const *p2, IggVec2 const *p3, IggVec2 const *p4, IggPackedColor col, float thickness);
+extern void iggAddQuadFilled(IggDrawList handle, IggVec2 const *p1, IggVec2 const *p2, IggVec2 const *p3, IggVec2 const *p4, IggPackedColor col);
+extern void iggAddNgon(IggDrawList handle, IggVec2 const *center, float radius, IggPackedColor col, int numSegments, float thickness);
+extern void iggAddNgonFilled(IggDrawList handle, IggVec2 const *center, float radius, IggPackedColor col, int numSegments);
+extern void iggAddEllipse(IggDrawList handle, IggVec2 const *center, IggVec2 const *radius, IggPackedColor col, float rot, int numSegments, float thickness);
+extern void iggAddEllipseFilled(IggDrawList handle, IggVec2 const *center, IggVec2 const *radius, IggPackedColor col, float rot, int numSegments);
+extern void iggAddBezierCubic(IggDrawList handle, IggVec2 const *p1, IggVec2 const *p2, IggVec2 const *p3, IggVec2 const *p4, IggPackedColor col, float thickness, int numSegments);
+extern void iggAddBezierQuadratic(IggDrawList handle, IggVec2 const *p1, IggVec2 const *p2, IggVec2 const *p3, IggPackedColor col, float thickness, int numSegments);
+extern void iggAddPolyline(IggDrawList handle, IggVec2 const *points, int count, IggPackedColor col, int flags, float thickness);
+extern void iggAddConvexPolyFilled(IggDrawList handle, IggVec2 const *points, int count, IggPackedColor col);
+extern void iggAddConcavePolyFilled(IggDrawList handle, IggVec2 const *points, int count, IggPackedColor col);
 extern void iggAddText(IggDrawList handle, IggVec2 const *pos, IggPackedColor col, const char *text, int length);
 extern void iggAddImage(IggDrawList handle, IggTextureID textureID, IggVec2* pMin, IggVec2* pMax, IggVec2* uvMin, IggVec2* uvMax, IggPackedColor col);
 extern void iggAddImageQuad(IggDrawList handle, IggTextureID textureID, IggVec2* p1, IggVec2* p2, IggVec2* p3, IggVec2* p4, IggVec2* uv1, IggVec2* uv2, IggVec2* uv3, IggVec2* uv4, IggPackedColor col);
+extern void iggAddImageRounded(IggDrawList handle, IggTextureID textureID, IggVec2 const *pMin, IggVec2 const *pMax, IggVec2 const *uvMin, IggVec2 const *uvMax, IggPackedColor col, float rounding, int flags);
+extern void iggAddCallback(IggDrawList handle, uintptr_t callback);
+extern void iggAddResetRenderState(IggDrawList handle);
+
+extern void iggPathClear(IggDrawList handle);
+extern void iggPathLineTo(IggDrawList handle, IggVec2 const *pos);
+extern void iggPathFillConvex(IggDrawList handle, IggPackedColor col);
+extern void iggPathFillConcave(IggDrawList handle, IggPackedColor col);
+extern void iggPathStroke(IggDrawList handle, IggPackedColor col, int flags, float thickness);
+extern void iggPathArcTo(IggDrawList handle, IggVec2 const *center, float radius, float aMin, float aMax, int numSegments);
+extern void iggPathArcToFast(IggDrawList handle, IggVec2 const *center, float radius, int aMinOf12, int aMaxOf12);
+extern void iggPathEllipticalArcTo(IggDrawList handle, IggVec2 const *center, IggVec2 const *radius, float rot, float aMin, float aMax, int numSegments);
+extern void iggPathBezierCubicCurveTo(IggDrawList handle, IggVec2 const *p2, IggVec2 const *p3, IggVec2 const *p4, int numSegments);
+extern void iggPathBezierQuadraticCurveTo(IggDrawList handle, IggVec2 const *p2, IggVec2 const *p3, int numSegments);
+extern void iggPathRect(IggDrawList handle, IggVec2 const *rectMin, IggVec2 const *rectMax, float rounding, int flags);
 
 extern void iggPushClipRect(IggDrawList handle, IggVec2 const *min, IggVec2 const *max, IggBool intersectWithCurrentClipRect);
 extern void iggPopClipRect(IggDrawList handle);
 
+extern void iggDrawListChannelsSplit(IggDrawList handle, int count);
+extern void iggDrawListChannelsMerge(IggDrawList handle);
+extern void iggDrawListChannelsSetCurrent(IggDrawList handle, int index);
+
 extern IggDrawList iggGetWindowDrawList();
 extern IggDrawList iggGetForegroundDrawList();
 extern IggDrawList iggGetBackgroundDrawList();
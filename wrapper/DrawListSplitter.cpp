@@ -0,0 +1,39 @@
+#include "ConfiguredImGui.h"
+
+#include "DrawListSplitter.h"
+#include "WrapperConverter.h"
+
+IggDrawListSplitter iggDrawListSplitterNew(void)
+{
+   return static_cast<IggDrawListSplitter>(IM_NEW(ImDrawListSplitter)());
+}
+
+void iggDrawListSplitterDelete(IggDrawListSplitter handle)
+{
+   ImDrawListSplitter *splitter = reinterpret_cast<ImDrawListSplitter *>(handle);
+   IM_DELETE(splitter);
+}
+
+void iggDrawListSplitterClear(IggDrawListSplitter handle)
+{
+   ImDrawListSplitter *splitter = reinterpret_cast<ImDrawListSplitter *>(handle);
+   splitter->Clear();
+}
+
+void iggDrawListSplitterSplit(IggDrawListSplitter handle, IggDrawList list, int count)
+{
+   ImDrawListSplitter *splitter = reinterpret_cast<ImDrawListSplitter *>(handle);
+   splitter->Split(reinterpret_cast<ImDrawList *>(list), count);
+}
+
+void iggDrawListSplitterMerge(IggDrawListSplitter handle, IggDrawList list)
+{
+   ImDrawListSplitter *splitter = reinterpret_cast<ImDrawListSplitter *>(handle);
+   splitter->Merge(reinterpret_cast<ImDrawList *>(list));
+}
+
+void iggDrawListSplitterSetCurrentChannel(IggDrawListSplitter handle, IggDrawList list, int index)
+{
+   ImDrawListSplitter *splitter = reinterpret_cast<ImDrawListSplitter *>(handle);
+   splitter->SetCurrentChannel(reinterpret_cast<ImDrawList *>(list), index);
+}
@@ -0,0 +1,18 @@
+#pragma once
+
+#include "Types.h"
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+extern IggDrawListSplitter iggDrawListSplitterNew(void);
+extern void iggDrawListSplitterDelete(IggDrawListSplitter handle);
+extern void iggDrawListSplitterClear(IggDrawListSplitter handle);
+extern void iggDrawListSplitterSplit(IggDrawListSplitter handle, IggDrawList list, int count);
+extern void iggDrawListSplitterMerge(IggDrawListSplitter handle, IggDrawList list);
+extern void iggDrawListSplitterSetCurrentChannel(IggDrawListSplitter handle, IggDrawList list, int index);
+
+#ifdef __cplusplus
+}
+#endif
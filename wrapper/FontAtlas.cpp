@@ -80,6 +80,22 @@ IggFont iggAddFontFromMemoryTTF(IggFontAtlas handle, char *font_data, int font_s
    return static_cast<IggFont>(font);
 }
 
+int iggFontAtlasGetFontCount(IggFontAtlas handle)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   return fontAtlas->Fonts.Size;
+}
+
+IggFont iggFontAtlasGetFont(IggFontAtlas handle, int index)
+{
+   ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
+   if ((index < 0) || (index >= fontAtlas->Fonts.Size))
+   {
+      return nullptr;
+   }
+   return static_cast<IggFont>(fontAtlas->Fonts[index]);
+}
+
 void iggFontAtlasSetTexDesiredWidth(IggFontAtlas handle, int value)
 {
    ImFontAtlas *fontAtlas = reinterpret_cast<ImFontAtlas *>(handle);
@@ -21,6 +21,9 @@ extern IggFont iggAddFontFromFileTTF(IggFontAtlas handle, char const *filename,
 extern IggFont iggAddFontFromMemoryTTF(IggFontAtlas handle, char *font_data, int font_size, float sizePixels,
    IggFontConfig config, IggGlyphRanges glyphRanges);
 
+extern int iggFontAtlasGetFontCount(IggFontAtlas handle);
+extern IggFont iggFontAtlasGetFont(IggFontAtlas handle, int index);
+
 extern void iggFontAtlasSetTexDesiredWidth(IggFontAtlas handle, int value);
 
 extern void iggFontAtlasGetTexDataAsAlpha8(IggFontAtlas handle, unsigned char **pixels,
@@ -1,4 +1,5 @@
 #include "ConfiguredImGui.h"
+#include "imgui_internal.h"
 
 #include "IO.h"
 #include "WrapperConverter.h"
@@ -34,6 +35,36 @@ IggBool iggWantTextInput(IggIO handle)
    return io->WantTextInput ? 1 : 0;
 }
 
+IggBool iggWantSetMousePos(IggIO handle)
+{
+   ImGuiIO *io = reinterpret_cast<ImGuiIO *>(handle);
+   return io->WantSetMousePos ? 1 : 0;
+}
+
+IggBool iggWantSaveIniSettings(IggIO handle)
+{
+   ImGuiIO *io = reinterpret_cast<ImGuiIO *>(handle);
+   return io->WantSaveIniSettings ? 1 : 0;
+}
+
+void iggIoSetWantSaveIniSettings(IggIO handle, IggBool value)
+{
+   ImGuiIO *io = reinterpret_cast<ImGuiIO *>(handle);
+   io->WantSaveIniSettings = value != 0;
+}
+
+IggBool iggNavActive(IggIO handle)
+{
+   ImGuiIO *io = reinterpret_cast<ImGuiIO *>(handle);
+   return io->NavActive ? 1 : 0;
+}
+
+IggBool iggNavVisible(IggIO handle)
+{
+   ImGuiIO *io = reinterpret_cast<ImGuiIO *>(handle);
+   return io->NavVisible ? 1 : 0;
+}
+
 extern float iggFramerate(IggIO handle)
 {
    ImGuiIO *io = reinterpret_cast<ImGuiIO *>(handle);
@@ -64,6 +95,27 @@ extern int iggMetricsActiveWindows(IggIO handle)
    return io->MetricsActiveWindows;
 }
 
+// iggMetricsRenderWindowsForViewport counts the windows of which the draw list was added
+// to the draw data of the viewport during the last Render().
+int iggMetricsRenderWindowsForViewport(IggIO handle, IggViewport viewport)
+{
+   ImGuiIO *io = reinterpret_cast<ImGuiIO *>(handle);
+   ImGuiViewportP *target = reinterpret_cast<ImGuiViewportP *>(viewport);
+   if (!target->DrawDataP.Valid)
+   {
+      return 0;
+   }
+   int count = 0;
+   for (ImGuiWindow *window : io->Ctx->Windows)
+   {
+      if (target->DrawDataP.CmdLists.contains(window->DrawList))
+      {
+         count++;
+      }
+   }
+   return count;
+}
+
 extern void iggMouseDelta(IggIO handle, IggVec2 *value)
 {
    ImGuiIO *io = reinterpret_cast<ImGuiIO *>(handle);
@@ -113,6 +165,45 @@ void iggIoSetMousePosition(IggIO handle, IggVec2 const *value)
    importValue(io->MousePos, *value);
 }
 
+int iggIoGetMouseClickedCount(IggIO handle, int button)
+{
+   ImGuiIO *io = reinterpret_cast<ImGuiIO *>(handle);
+   if ((button < 0) || (button >= IM_ARRAYSIZE(io->MouseClickedCount)))
+   {
+      return 0;
+   }
+   return io->MouseClickedCount[button];
+}
+
+float iggIoGetMouseDownDuration(IggIO handle, int button)
+{
+   ImGuiIO *io = reinterpret_cast<ImGuiIO *>(handle);
+   if ((button < 0) || (button >= IM_ARRAYSIZE(io->MouseDownDuration)))
+   {
+      return -1.0f;
+   }
+   return io->MouseDownDuration[button];
+}
+
+void iggIoGetKeyData(IggIO handle, int key, IggKeyData *out)
+{
+   ImGuiIO *io = reinterpret_cast<ImGuiIO *>(handle);
+   ImGuiKey imguiKey = static_cast<ImGuiKey>(key);
+   if (!ImGui::IsNamedKeyOrMod(imguiKey))
+   {
+      out->Down = 0;
+      out->DownDuration = -1.0f;
+      out->DownDurationPrev = -1.0f;
+      out->AnalogValue = 0.0f;
+      return;
+   }
+   ImGuiKeyData *data = ImGui::GetKeyData(io->Ctx, imguiKey);
+   out->Down = data->Down ? 1 : 0;
+   out->DownDuration = data->DownDuration;
+   out->DownDurationPrev = data->DownDurationPrev;
+   out->AnalogValue = data->AnalogValue;
+}
+
 void iggIoSetMouseButtonDown(IggIO handle, int index, IggBool value)
 {
    ImGuiIO *io = reinterpret_cast<ImGuiIO *>(handle);
@@ -180,6 +271,174 @@ void iggIoAddInputCharactersUTF8(IggIO handle, char const *utf8Chars)
    io.AddInputCharactersUTF8(utf8Chars);
 }
 
+void iggIoAddKeyAnalogEvent(IggIO handle, int key, IggBool down, float value)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   io.AddKeyAnalogEvent((ImGuiKey)key, down != 0, value);
+}
+
+void iggIoAddMousePosEvent(IggIO handle, float x, float y)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   io.AddMousePosEvent(x, y);
+}
+
+void iggIoAddMouseButtonEvent(IggIO handle, int button, IggBool down)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   io.AddMouseButtonEvent(button, down != 0);
+}
+
+void iggIoAddMouseWheelEvent(IggIO handle, float wheelX, float wheelY)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   io.AddMouseWheelEvent(wheelX, wheelY);
+}
+
+void iggIoAddMouseSourceEvent(IggIO handle, int source)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   io.AddMouseSourceEvent((ImGuiMouseSource)source);
+}
+
+void iggIoAddFocusEvent(IggIO handle, IggBool focused)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   io.AddFocusEvent(focused != 0);
+}
+
+void iggIoAddInputCharacterUTF16(IggIO handle, unsigned short c)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   io.AddInputCharacterUTF16(c);
+}
+
+void iggIoSetKeyEventNativeData(IggIO handle, int key, int nativeKeycode, int nativeScancode, int nativeLegacyIndex)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   io.SetKeyEventNativeData((ImGuiKey)key, nativeKeycode, nativeScancode, nativeLegacyIndex);
+}
+
+void iggIoClearInputKeys(IggIO handle)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   io.ClearInputKeys();
+}
+
+void iggIoClearInputMouse(IggIO handle)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   io.ClearInputMouse();
+}
+
+void iggIoSetConfigInputTrickleEventQueue(IggIO handle, IggBool value)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   io.ConfigInputTrickleEventQueue = value != 0;
+}
+
+IggBool iggIoGetConfigInputTrickleEventQueue(IggIO handle)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   return io.ConfigInputTrickleEventQueue ? 1 : 0;
+}
+
+int iggIoGetConfigFlags(IggIO handle)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   return io.ConfigFlags;
+}
+
+void iggIoGetConfig(IggIO handle, IggIOConfig *out)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   out->ConfigFlags = io.ConfigFlags;
+   out->IniSavingRate = io.IniSavingRate;
+   out->ConfigNavSwapGamepadButtons = io.ConfigNavSwapGamepadButtons ? 1 : 0;
+   out->ConfigNavMoveSetMousePos = io.ConfigNavMoveSetMousePos ? 1 : 0;
+   out->ConfigNavCaptureKeyboard = io.ConfigNavCaptureKeyboard ? 1 : 0;
+   out->ConfigNavEscapeClearFocusItem = io.ConfigNavEscapeClearFocusItem ? 1 : 0;
+   out->ConfigNavEscapeClearFocusWindow = io.ConfigNavEscapeClearFocusWindow ? 1 : 0;
+   out->ConfigNavCursorVisibleAuto = io.ConfigNavCursorVisibleAuto ? 1 : 0;
+   out->ConfigNavCursorVisibleAlways = io.ConfigNavCursorVisibleAlways ? 1 : 0;
+   out->ConfigDockingNoSplit = io.ConfigDockingNoSplit ? 1 : 0;
+   out->ConfigDockingWithShift = io.ConfigDockingWithShift ? 1 : 0;
+   out->ConfigDockingAlwaysTabBar = io.ConfigDockingAlwaysTabBar ? 1 : 0;
+   out->ConfigDockingTransparentPayload = io.ConfigDockingTransparentPayload ? 1 : 0;
+   out->MouseDrawCursor = io.MouseDrawCursor ? 1 : 0;
+   out->ConfigMacOSXBehaviors = io.ConfigMacOSXBehaviors ? 1 : 0;
+   out->ConfigInputTrickleEventQueue = io.ConfigInputTrickleEventQueue ? 1 : 0;
+   out->ConfigInputTextCursorBlink = io.ConfigInputTextCursorBlink ? 1 : 0;
+   out->ConfigInputTextEnterKeepActive = io.ConfigInputTextEnterKeepActive ? 1 : 0;
+   out->ConfigDragClickToInputText = io.ConfigDragClickToInputText ? 1 : 0;
+   out->ConfigWindowsResizeFromEdges = io.ConfigWindowsResizeFromEdges ? 1 : 0;
+   out->ConfigWindowsMoveFromTitleBarOnly = io.ConfigWindowsMoveFromTitleBarOnly ? 1 : 0;
+   out->ConfigWindowsCopyContentsWithCtrlC = io.ConfigWindowsCopyContentsWithCtrlC ? 1 : 0;
+   out->ConfigScrollbarScrollByPage = io.ConfigScrollbarScrollByPage ? 1 : 0;
+   out->ConfigMemoryCompactTimer = io.ConfigMemoryCompactTimer;
+   out->MouseDoubleClickTime = io.MouseDoubleClickTime;
+   out->MouseDoubleClickMaxDist = io.MouseDoubleClickMaxDist;
+   out->MouseDragThreshold = io.MouseDragThreshold;
+   out->KeyRepeatDelay = io.KeyRepeatDelay;
+   out->KeyRepeatRate = io.KeyRepeatRate;
+   out->ConfigErrorRecovery = io.ConfigErrorRecovery ? 1 : 0;
+   out->ConfigErrorRecoveryEnableAssert = io.ConfigErrorRecoveryEnableAssert ? 1 : 0;
+   out->ConfigErrorRecoveryEnableDebugLog = io.ConfigErrorRecoveryEnableDebugLog ? 1 : 0;
+   out->ConfigErrorRecoveryEnableTooltip = io.ConfigErrorRecoveryEnableTooltip ? 1 : 0;
+   out->ConfigDebugIsDebuggerPresent = io.ConfigDebugIsDebuggerPresent ? 1 : 0;
+   out->ConfigDebugHighlightIdConflicts = io.ConfigDebugHighlightIdConflicts ? 1 : 0;
+   out->ConfigDebugHighlightIdConflictsShowItemPicker = io.ConfigDebugHighlightIdConflictsShowItemPicker ? 1 : 0;
+   out->ConfigDebugBeginReturnValueOnce = io.ConfigDebugBeginReturnValueOnce ? 1 : 0;
+   out->ConfigDebugBeginReturnValueLoop = io.ConfigDebugBeginReturnValueLoop ? 1 : 0;
+   out->ConfigDebugIgnoreFocusLoss = io.ConfigDebugIgnoreFocusLoss ? 1 : 0;
+   out->ConfigDebugIniSettings = io.ConfigDebugIniSettings ? 1 : 0;
+}
+
+void iggIoSetConfig(IggIO handle, IggIOConfig const *config)
+{
+   ImGuiIO &io = *reinterpret_cast<ImGuiIO *>(handle);
+   io.ConfigFlags = config->ConfigFlags;
+   io.IniSavingRate = config->IniSavingRate;
+   io.ConfigNavSwapGamepadButtons = config->ConfigNavSwapGamepadButtons != 0;
+   io.ConfigNavMoveSetMousePos = config->ConfigNavMoveSetMousePos != 0;
+   io.ConfigNavCaptureKeyboard = config->ConfigNavCaptureKeyboard != 0;
+   io.ConfigNavEscapeClearFocusItem = config->ConfigNavEscapeClearFocusItem != 0;
+   io.ConfigNavEscapeClearFocusWindow = config->ConfigNavEscapeClearFocusWindow != 0;
+   io.ConfigNavCursorVisibleAuto = config->ConfigNavCursorVisibleAuto != 0;
+   io.ConfigNavCursorVisibleAlways = config->ConfigNavCursorVisibleAlways != 0;
+   io.ConfigDockingNoSplit = config->ConfigDockingNoSplit != 0;
+   io.ConfigDockingWithShift = config->ConfigDockingWithShift != 0;
+   io.ConfigDockingAlwaysTabBar = config->ConfigDockingAlwaysTabBar != 0;
+   io.ConfigDockingTransparentPayload = config->ConfigDockingTransparentPayload != 0;
+   io.MouseDrawCursor = config->MouseDrawCursor != 0;
+   io.ConfigMacOSXBehaviors = config->ConfigMacOSXBehaviors != 0;
+   io.ConfigInputTrickleEventQueue = config->ConfigInputTrickleEventQueue != 0;
+   io.ConfigInputTextCursorBlink = config->ConfigInputTextCursorBlink != 0;
+   io.ConfigInputTextEnterKeepActive = config->ConfigInputTextEnterKeepActive != 0;
+   io.ConfigDragClickToInputText = config->ConfigDragClickToInputText != 0;
+   io.ConfigWindowsResizeFromEdges = config->ConfigWindowsResizeFromEdges != 0;
+   io.ConfigWindowsMoveFromTitleBarOnly = config->ConfigWindowsMoveFromTitleBarOnly != 0;
+   io.ConfigWindowsCopyContentsWithCtrlC = config->ConfigWindowsCopyContentsWithCtrlC != 0;
+   io.ConfigScrollbarScrollByPage = config->ConfigScrollbarScrollByPage != 0;
+   io.ConfigMemoryCompactTimer = config->ConfigMemoryCompactTimer;
+   io.MouseDoubleClickTime = config->MouseDoubleClickTime;
+   io.MouseDoubleClickMaxDist = config->MouseDoubleClickMaxDist;
+   io.MouseDragThreshold = config->MouseDragThreshold;
+   io.KeyRepeatDelay = config->KeyRepeatDelay;
+   io.KeyRepeatRate = config->KeyRepeatRate;
+   io.ConfigErrorRecovery = config->ConfigErrorRecovery != 0;
+   io.ConfigErrorRecoveryEnableAssert = config->ConfigErrorRecoveryEnableAssert != 0;
+   io.ConfigErrorRecoveryEnableDebugLog = config->ConfigErrorRecoveryEnableDebugLog != 0;
+   io.ConfigErrorRecoveryEnableTooltip = config->ConfigErrorRecoveryEnableTooltip != 0;
+   io.ConfigDebugIsDebuggerPresent = config->ConfigDebugIsDebuggerPresent != 0;
+   io.ConfigDebugHighlightIdConflicts = config->ConfigDebugHighlightIdConflicts != 0;
+   io.ConfigDebugHighlightIdConflictsShowItemPicker = config->ConfigDebugHighlightIdConflictsShowItemPicker != 0;
+   io.ConfigDebugBeginReturnValueOnce = config->ConfigDebugBeginReturnValueOnce != 0;
+   io.ConfigDebugBeginReturnValueLoop = config->ConfigDebugBeginReturnValueLoop != 0;
+   io.ConfigDebugIgnoreFocusLoss = config->ConfigDebugIgnoreFocusLoss != 0;
+   io.ConfigDebugIniSettings = config->ConfigDebugIniSettings != 0;
+}
+
 void iggIoSetIniFilename(IggIO handle, char const *value)
 {
    static std::string bufferValue;
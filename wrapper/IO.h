@@ -6,22 +6,83 @@
 extern "C" {
 #endif
 
+typedef struct tagIggIOConfig
+{
+   int     ConfigFlags;
+   float   IniSavingRate;
+   IggBool ConfigNavSwapGamepadButtons;
+   IggBool ConfigNavMoveSetMousePos;
+   IggBool ConfigNavCaptureKeyboard;
+   IggBool ConfigNavEscapeClearFocusItem;
+   IggBool ConfigNavEscapeClearFocusWindow;
+   IggBool ConfigNavCursorVisibleAuto;
+   IggBool ConfigNavCursorVisibleAlways;
+   IggBool ConfigDockingNoSplit;
+   IggBool ConfigDockingWithShift;
+   IggBool ConfigDockingAlwaysTabBar;
+   IggBool ConfigDockingTransparentPayload;
+   IggBool MouseDrawCursor;
+   IggBool ConfigMacOSXBehaviors;
+   IggBool ConfigInputTrickleEventQueue;
+   IggBool ConfigInputTextCursorBlink;
+   IggBool ConfigInputTextEnterKeepActive;
+   IggBool ConfigDragClickToInputText;
+   IggBool ConfigWindowsResizeFromEdges;
+   IggBool ConfigWindowsMoveFromTitleBarOnly;
+   IggBool ConfigWindowsCopyContentsWithCtrlC;
+   IggBool ConfigScrollbarScrollByPage;
+   float   ConfigMemoryCompactTimer;
+   float   MouseDoubleClickTime;
+   float   MouseDoubleClickMaxDist;
+   float   MouseDragThreshold;
+   float   KeyRepeatDelay;
+   float   KeyRepeatRate;
+   IggBool ConfigErrorRecovery;
+   IggBool ConfigErrorRecoveryEnableAssert;
+   IggBool ConfigErrorRecoveryEnableDebugLog;
+   IggBool ConfigErrorRecoveryEnableTooltip;
+   IggBool ConfigDebugIsDebuggerPresent;
+   IggBool ConfigDebugHighlightIdConflicts;
+   IggBool ConfigDebugHighlightIdConflictsShowItemPicker;
+   IggBool ConfigDebugBeginReturnValueOnce;
+   IggBool ConfigDebugBeginReturnValueLoop;
+   IggBool ConfigDebugIgnoreFocusLoss;
+   IggBool ConfigDebugIniSettings;
+} IggIOConfig;
+
+typedef struct tagIggKeyData
+{
+   IggBool Down;
+   float DownDuration;
+   float DownDurationPrev;
+   float AnalogValue;
+} IggKeyData;
+
 extern IggIO iggGetCurrentIO(void);
 
 extern IggBool iggWantCaptureMouse(IggIO handle);
 extern IggBool iggWantCaptureMouseUnlessPopupClose(IggIO handle);
 extern IggBool iggWantCaptureKeyboard(IggIO handle);
 extern IggBool iggWantTextInput(IggIO handle);
+extern IggBool iggWantSetMousePos(IggIO handle);
+extern IggBool iggWantSaveIniSettings(IggIO handle);
+extern void iggIoSetWantSaveIniSettings(IggIO handle, IggBool value);
+extern IggBool iggNavActive(IggIO handle);
+extern IggBool iggNavVisible(IggIO handle);
 extern float iggFramerate(IggIO handle);
 extern int iggMetricsRenderVertices(IggIO handle);
 extern int iggMetricsRenderIndices(IggIO handle);
 extern int iggMetricsRenderWindows(IggIO handle);
 extern int iggMetricsActiveWindows(IggIO handle);
+extern int iggMetricsRenderWindowsForViewport(IggIO handle, IggViewport viewport);
 extern int iggMetricsActiveAllocations(IggIO handle);
 extern void iggMouseDelta(IggIO handle, IggVec2 *value);
 extern void iggMouseWheel(IggIO handle, float *mouseWheelH, float *mouseWheel);
 extern void iggDisplayFrameBufferScale(IggIO handle, IggVec2 *value);
 extern IggFontAtlas iggIoGetFonts(IggIO handle);
+extern int iggIoGetMouseClickedCount(IggIO handle, int button);
+extern float iggIoGetMouseDownDuration(IggIO handle, int button);
+extern void iggIoGetKeyData(IggIO handle, int key, IggKeyData *out);
 
 extern void iggIoSetDisplaySize(IggIO handle, IggVec2 const *value);
 extern void iggIoSetDisplayFrameBufferScale(IggIO handle, IggVec2 const *value);
@@ -39,6 +100,23 @@ extern IggBool iggIoKeyAltPressed(IggIO handle);
 extern IggBool iggIoKeySuperPressed(IggIO handle);
 extern void iggIoAddInputCharactersUTF8(IggIO handle, char const *utf8Chars);
 
+extern void iggIoAddKeyAnalogEvent(IggIO handle, int key, IggBool down, float value);
+extern void iggIoAddMousePosEvent(IggIO handle, float x, float y);
+extern void iggIoAddMouseButtonEvent(IggIO handle, int button, IggBool down);
+extern void iggIoAddMouseWheelEvent(IggIO handle, float wheelX, float wheelY);
+extern void iggIoAddMouseSourceEvent(IggIO handle, int source);
+extern void iggIoAddFocusEvent(IggIO handle, IggBool focused);
+extern void iggIoAddInputCharacterUTF16(IggIO handle, unsigned short c);
+extern void iggIoSetKeyEventNativeData(IggIO handle, int key, int nativeKeycode, int nativeScancode, int nativeLegacyIndex);
+extern void iggIoClearInputKeys(IggIO handle);
+extern void iggIoClearInputMouse(IggIO handle);
+extern void iggIoSetConfigInputTrickleEventQueue(IggIO handle, IggBool value);
+extern IggBool iggIoGetConfigInputTrickleEventQueue(IggIO handle);
+
+extern int iggIoGetConfigFlags(IggIO handle);
+extern void iggIoGetConfig(IggIO handle, IggIOConfig *out);
+extern void iggIoSetConfig(IggIO handle, IggIOConfig const *config);
+
 extern void iggIoSetIniFilename(IggIO handle, char const *value);
 extern void iggIoSetConfigFlags(IggIO handle, int flags);
 extern void iggIoSetBackendFlags(IggIO handle, int flags);
@@ -0,0 +1,256 @@
+#include "ConfiguredImGui.h"
+#include "imgui_internal.h"
+
+#include "ItemRegistry.h"
+#include "WrapperConverter.h"
+
+#include <string>
+#include <unordered_map>
+#include <vector>
+
+// The item registry collects the items of a frame via the hooks of the test engine.
+// It is stored as the TestEngine user data of the context, and cleared at the start of every frame.
+// The interaction state of the items is determined at the end of the frame.
+struct IggItemRegistryEntry
+{
+   ImGuiID id;
+   ImGuiID windowID;
+   std::string window;
+   std::string label;
+   ImRect rect;
+   ImRect clipRect;
+   bool hovered;
+   bool active;
+   bool focused;
+};
+
+struct IggItemRegistry
+{
+   ImGuiID newFrameHook;
+   ImGuiID endFrameHook;
+   ImGuiID shutdownHook;
+   std::vector<IggItemRegistryEntry> items;
+   std::unordered_map<ImGuiID, size_t> indexByID;
+};
+
+static IggItemRegistry *iggItemRegistryOf(ImGuiContext *ctx)
+{
+   if (ctx == nullptr)
+   {
+      return nullptr;
+   }
+   return reinterpret_cast<IggItemRegistry *>(ctx->TestEngine);
+}
+
+static IggItemRegistryEntry *iggItemRegistryFind(IggItemRegistry *registry, ImGuiID id)
+{
+   auto it = registry->indexByID.find(id);
+   if (it == registry->indexByID.end())
+   {
+      return nullptr;
+   }
+   return &registry->items[it->second];
+}
+
+static void iggItemRegistryNewFrame(ImGuiContext *ctx, ImGuiContextHook *)
+{
+   IggItemRegistry *registry = iggItemRegistryOf(ctx);
+   if (registry != nullptr)
+   {
+      registry->items.clear();
+      registry->indexByID.clear();
+   }
+}
+
+static void iggItemRegistryEndFrame(ImGuiContext *ctx, ImGuiContextHook *)
+{
+   IggItemRegistry *registry = iggItemRegistryOf(ctx);
+   if (registry == nullptr)
+   {
+      return;
+   }
+   for (IggItemRegistryEntry &entry : registry->items)
+   {
+      if (entry.id == entry.windowID)
+      {
+         entry.hovered = (ctx->HoveredWindow != nullptr) && (ctx->HoveredWindow->ID == entry.id);
+         entry.focused = (ctx->NavWindow != nullptr) && (ctx->NavWindow->ID == entry.id);
+      }
+      else
+      {
+         entry.hovered = ctx->HoveredId == entry.id;
+         entry.focused = (ctx->NavId == entry.id) && (ctx->NavWindow != nullptr) && (ctx->NavWindow->ID == entry.windowID);
+      }
+      entry.active = ctx->ActiveId == entry.id;
+   }
+}
+
+static void iggItemRegistryDisable(ImGuiContext *ctx)
+{
+   IggItemRegistry *registry = iggItemRegistryOf(ctx);
+   if (registry == nullptr)
+   {
+      return;
+   }
+   ImGui::RemoveContextHook(ctx, registry->newFrameHook);
+   ImGui::RemoveContextHook(ctx, registry->endFrameHook);
+   ImGui::RemoveContextHook(ctx, registry->shutdownHook);
+   ctx->TestEngine = nullptr;
+   ctx->TestEngineHookItems = false;
+   delete registry;
+}
+
+static void iggItemRegistryShutdown(ImGuiContext *ctx, ImGuiContextHook *)
+{
+   iggItemRegistryDisable(ctx);
+}
+
+void ImGuiTestEngineHook_ItemAdd(ImGuiContext *ctx, ImGuiID id, const ImRect &bb, const ImGuiLastItemData *)
+{
+   IggItemRegistry *registry = iggItemRegistryOf(ctx);
+   if ((registry == nullptr) || (id == 0))
+   {
+      return;
+   }
+   ImGuiWindow *window = ctx->CurrentWindow;
+   if ((window != nullptr) && window->Hidden)
+   {
+      // Items of hidden windows, such as windows measuring their size in their first frame, can't be interacted with.
+      return;
+   }
+
+   // An item may be registered more than once per frame. The latest registration is relevant.
+   IggItemRegistryEntry *entry = iggItemRegistryFind(registry, id);
+   if (entry == nullptr)
+   {
+      registry->indexByID[id] = registry->items.size();
+      registry->items.emplace_back();
+      entry = &registry->items.back();
+      entry->id = id;
+   }
+   entry->windowID = (window != nullptr) ? window->ID : 0;
+   entry->window = (window != nullptr) ? window->Name : "";
+   entry->rect = bb;
+   entry->clipRect = (window != nullptr) ? window->ClipRect : bb;
+   entry->hovered = false;
+   entry->active = false;
+   entry->focused = false;
+}
+
+void ImGuiTestEngineHook_ItemInfo(ImGuiContext *ctx, ImGuiID id, const char *label, ImGuiItemStatusFlags)
+{
+   IggItemRegistry *registry = iggItemRegistryOf(ctx);
+   if (registry == nullptr)
+   {
+      return;
+   }
+   IggItemRegistryEntry *entry = iggItemRegistryFind(registry, id);
+   if ((entry != nullptr) && (label != nullptr))
+   {
+      entry->label = label;
+   }
+}
+
+void ImGuiTestEngineHook_Log(ImGuiContext *, const char *, ...)
+{
+}
+
+const char *ImGuiTestEngine_FindItemDebugLabel(ImGuiContext *ctx, ImGuiID id)
+{
+   IggItemRegistry *registry = iggItemRegistryOf(ctx);
+   if (registry == nullptr)
+   {
+      return nullptr;
+   }
+   IggItemRegistryEntry *entry = iggItemRegistryFind(registry, id);
+   if ((entry == nullptr) || entry->label.empty())
+   {
+      return nullptr;
+   }
+   return entry->label.c_str();
+}
+
+void iggSetItemRegistryEnabled(IggBool enabled)
+{
+   ImGuiContext *ctx = ImGui::GetCurrentContext();
+   if (ctx == nullptr)
+   {
+      return;
+   }
+   if (enabled == 0)
+   {
+      iggItemRegistryDisable(ctx);
+      return;
+   }
+   if (iggItemRegistryOf(ctx) != nullptr)
+   {
+      return;
+   }
+
+   IggItemRegistry *registry = new IggItemRegistry();
+   ImGuiContextHook hook;
+   hook.Type = ImGuiContextHookType_NewFramePre;
+   hook.Callback = iggItemRegistryNewFrame;
+   registry->newFrameHook = ImGui::AddContextHook(ctx, &hook);
+   hook.Type = ImGuiContextHookType_EndFramePost;
+   hook.Callback = iggItemRegistryEndFrame;
+   registry->endFrameHook = ImGui::AddContextHook(ctx, &hook);
+   hook.Type = ImGuiContextHookType_Shutdown;
+   hook.Callback = iggItemRegistryShutdown;
+   registry->shutdownHook = ImGui::AddContextHook(ctx, &hook);
+
+   ctx->TestEngine = registry;
+   ctx->TestEngineHookItems = true;
+}
+
+IggBool iggIsItemRegistryEnabled(void)
+{
+   return (iggItemRegistryOf(ImGui::GetCurrentContext()) != nullptr) ? 1 : 0;
+}
+
+static void iggExportRegisteredItem(IggItemRegistryEntry const &entry, IggRegisteredItem *out)
+{
+   out->ID = entry.id;
+   out->WindowID = entry.windowID;
+   out->Window = entry.window.c_str();
+   out->Label = entry.label.c_str();
+   exportValue(out->Min, entry.rect.Min);
+   exportValue(out->Max, entry.rect.Max);
+   exportValue(out->ClipMin, entry.clipRect.Min);
+   exportValue(out->ClipMax, entry.clipRect.Max);
+   out->Hovered = entry.hovered ? 1 : 0;
+   out->Active = entry.active ? 1 : 0;
+   out->Focused = entry.focused ? 1 : 0;
+}
+
+int iggRegisteredItemCount(void)
+{
+   IggItemRegistry *registry = iggItemRegistryOf(ImGui::GetCurrentContext());
+   if (registry == nullptr)
+   {
+      return 0;
+   }
+   return static_cast<int>(registry->items.size());
+}
+
+void iggGetRegisteredItem(int index, IggRegisteredItem *out)
+{
+   IggItemRegistry *registry = iggItemRegistryOf(ImGui::GetCurrentContext());
+   iggExportRegisteredItem(registry->items[index], out);
+}
+
+IggBool iggLookupRegisteredItem(unsigned int id, IggRegisteredItem *out)
+{
+   IggItemRegistry *registry = iggItemRegistryOf(ImGui::GetCurrentContext());
+   if (registry == nullptr)
+   {
+      return 0;
+   }
+   IggItemRegistryEntry *entry = iggItemRegistryFind(registry, id);
+   if (entry == nullptr)
+   {
+      return 0;
+   }
+   iggExportRegisteredItem(*entry, out);
+   return 1;
+}
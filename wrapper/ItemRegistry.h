@@ -0,0 +1,32 @@
+#pragma once
+
+#include "Types.h"
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+typedef struct tagIggRegisteredItem
+{
+   unsigned int ID;
+   unsigned int WindowID;
+   char const *Window;
+   char const *Label;
+   IggVec2 Min;
+   IggVec2 Max;
+   IggVec2 ClipMin;
+   IggVec2 ClipMax;
+   IggBool Hovered;
+   IggBool Active;
+   IggBool Focused;
+} IggRegisteredItem;
+
+extern void iggSetItemRegistryEnabled(IggBool enabled);
+extern IggBool iggIsItemRegistryEnabled(void);
+extern int iggRegisteredItemCount(void);
+extern void iggGetRegisteredItem(int index, IggRegisteredItem *out);
+extern IggBool iggLookupRegisteredItem(unsigned int id, IggRegisteredItem *out);
+
+#ifdef __cplusplus
+}
+#endif
@@ -1,4 +1,5 @@
 #include "ConfiguredImGui.h"
+#include "imgui_internal.h"
 
 #include "Layout.h"
 #include "WrapperConverter.h"
@@ -18,6 +19,16 @@ void iggPopID(void)
    ImGui::PopID();
 }
 
+unsigned int iggGetID(char const *id)
+{
+   return ImGui::GetID(id);
+}
+
+unsigned int iggGetIDWithSeed(char const *id, unsigned int seed)
+{
+   return ImGui::GetIDWithSeed(id, nullptr, seed);
+}
+
 void iggSeparator(void)
 {
    ImGui::Separator();
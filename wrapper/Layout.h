@@ -9,6 +9,8 @@ extern "C" {
 extern void iggPushID(char const *id);
 extern void iggPushIDInt(int id);
 extern void iggPopID(void);
+extern unsigned int iggGetID(char const *id);
+extern unsigned int iggGetIDWithSeed(char const *id, unsigned int seed);
 
 extern void iggSeparator(void);
 extern void iggSameLine(float posX, float spacingW);
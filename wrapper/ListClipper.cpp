@@ -1,4 +1,5 @@
 #include "ConfiguredImGui.h"
+#include "imgui_internal.h"
 
 #include "ListClipper.h"
 #include "WrapperConverter.h"
@@ -7,10 +8,21 @@
 #include <cstdint>
 #include <iostream>
 
+// Within a multi-select scope, the source item of a range selection must never be clipped.
+// This assumes the selection user data are item indices, as recommended.
+static void iggListClipperIncludeRangeSrcItem(ImGuiListClipper &clipper, int items_count)
+{
+   ImGuiMultiSelectTempData *multiSelect = GImGui->CurrentMultiSelect;
+   if ((multiSelect != NULL) && (multiSelect->IO.RangeSrcItem >= 0) && (multiSelect->IO.RangeSrcItem < items_count)) {
+	   clipper.IncludeItemByIndex(static_cast<int>(multiSelect->IO.RangeSrcItem));
+   }
+}
+
 void iggListClipperAll(IggListClipperResults *results, int items_count, float items_height, uintptr_t draw)
 {
    ImGuiListClipper imguiClipper;
    imguiClipper.Begin(items_count, items_height);
+   iggListClipperIncludeRangeSrcItem(imguiClipper, items_count);
    while (imguiClipper.Step()) {
 	   for (int i = imguiClipper.DisplayStart; i < imguiClipper.DisplayEnd; ++i) {
 		   listClipperDraw(draw, i);
@@ -20,3 +32,49 @@ void iggListClipperAll(IggListClipperResults *results, int items_count, float it
    results->DisplayEnd = imguiClipper.DisplayEnd;
    results->ItemsHeight = imguiClipper.ItemsHeight;
 }
+
+IggListClipper iggListClipperNew(void)
+{
+   return static_cast<IggListClipper>(IM_NEW(ImGuiListClipper)());
+}
+
+void iggListClipperDelete(IggListClipper handle)
+{
+   ImGuiListClipper *clipper = reinterpret_cast<ImGuiListClipper *>(handle);
+   IM_DELETE(clipper);
+}
+
+void iggListClipperBegin(IggListClipper handle, int items_count, float items_height)
+{
+   ImGuiListClipper *clipper = reinterpret_cast<ImGuiListClipper *>(handle);
+   clipper->Begin(items_count, items_height);
+   iggListClipperIncludeRangeSrcItem(*clipper, items_count);
+}
+
+void iggListClipperEnd(IggListClipper handle)
+{
+   ImGuiListClipper *clipper = reinterpret_cast<ImGuiListClipper *>(handle);
+   clipper->End();
+}
+
+IggBool iggListClipperStep(IggListClipper handle, IggListClipperResults *results)
+{
+   ImGuiListClipper *clipper = reinterpret_cast<ImGuiListClipper *>(handle);
+   bool more = clipper->Step();
+   results->DisplayStart = clipper->DisplayStart;
+   results->DisplayEnd = clipper->DisplayEnd;
+   results->ItemsHeight = clipper->ItemsHeight;
+   return more ? 1 : 0;
+}
+
+void iggListClipperIncludeItemsByIndex(IggListClipper handle, int item_begin, int item_end)
+{
+   ImGuiListClipper *clipper = reinterpret_cast<ImGuiListClipper *>(handle);
+   clipper->IncludeItemsByIndex(item_begin, item_end);
+}
+
+void iggListClipperSeekCursorForItem(IggListClipper handle, int item_index)
+{
+   ImGuiListClipper *clipper = reinterpret_cast<ImGuiListClipper *>(handle);
+   clipper->SeekCursorForItem(item_index);
+}
@@ -15,6 +15,14 @@ typedef struct tagIggListClipperResults
 
 extern void iggListClipperAll(IggListClipperResults *results, int items_count, float items_height, uintptr_t draw);
 
+extern IggListClipper iggListClipperNew(void);
+extern void iggListClipperDelete(IggListClipper handle);
+extern void iggListClipperBegin(IggListClipper handle, int items_count, float items_height);
+extern void iggListClipperEnd(IggListClipper handle);
+extern IggBool iggListClipperStep(IggListClipper handle, IggListClipperResults *results);
+extern void iggListClipperIncludeItemsByIndex(IggListClipper handle, int item_begin, int item_end);
+extern void iggListClipperSeekCursorForItem(IggListClipper handle, int item_index);
+
 #ifdef __cplusplus
 }
 #endif
@@ -0,0 +1,71 @@
+#include "ConfiguredImGui.h"
+
+#include "MultiSelect.h"
+#include "WrapperConverter.h"
+
+IggMultiSelectIO iggBeginMultiSelect(int flags, int selectionSize, int itemsCount)
+{
+   return static_cast<IggMultiSelectIO>(ImGui::BeginMultiSelect(flags, selectionSize, itemsCount));
+}
+
+IggMultiSelectIO iggEndMultiSelect(void)
+{
+   return static_cast<IggMultiSelectIO>(ImGui::EndMultiSelect());
+}
+
+void iggSetNextItemSelectionUserData(int64_t selectionUserData)
+{
+   ImGui::SetNextItemSelectionUserData(selectionUserData);
+}
+
+IggBool iggIsItemToggledSelection(void)
+{
+   return ImGui::IsItemToggledSelection() ? 1 : 0;
+}
+
+int iggMultiSelectIOGetRequestsCount(IggMultiSelectIO handle)
+{
+   ImGuiMultiSelectIO *io = reinterpret_cast<ImGuiMultiSelectIO *>(handle);
+   return io->Requests.Size;
+}
+
+void iggMultiSelectIOGetRequest(IggMultiSelectIO handle, int index, IggSelectionRequest *out)
+{
+   ImGuiMultiSelectIO *io = reinterpret_cast<ImGuiMultiSelectIO *>(handle);
+   ImGuiSelectionRequest const &request = io->Requests[index];
+   out->Type = request.Type;
+   out->Selected = request.Selected ? 1 : 0;
+   out->RangeDirection = request.RangeDirection;
+   out->RangeFirstItem = request.RangeFirstItem;
+   out->RangeLastItem = request.RangeLastItem;
+}
+
+int64_t iggMultiSelectIOGetRangeSrcItem(IggMultiSelectIO handle)
+{
+   ImGuiMultiSelectIO *io = reinterpret_cast<ImGuiMultiSelectIO *>(handle);
+   return io->RangeSrcItem;
+}
+
+int64_t iggMultiSelectIOGetNavIdItem(IggMultiSelectIO handle)
+{
+   ImGuiMultiSelectIO *io = reinterpret_cast<ImGuiMultiSelectIO *>(handle);
+   return io->NavIdItem;
+}
+
+IggBool iggMultiSelectIOGetNavIdSelected(IggMultiSelectIO handle)
+{
+   ImGuiMultiSelectIO *io = reinterpret_cast<ImGuiMultiSelectIO *>(handle);
+   return io->NavIdSelected ? 1 : 0;
+}
+
+void iggMultiSelectIOSetRangeSrcReset(IggMultiSelectIO handle, IggBool value)
+{
+   ImGuiMultiSelectIO *io = reinterpret_cast<ImGuiMultiSelectIO *>(handle);
+   io->RangeSrcReset = value != 0;
+}
+
+int iggMultiSelectIOGetItemsCount(IggMultiSelectIO handle)
+{
+   ImGuiMultiSelectIO *io = reinterpret_cast<ImGuiMultiSelectIO *>(handle);
+   return io->ItemsCount;
+}
@@ -0,0 +1,33 @@
+#pragma once
+
+#include "Types.h"
+
+#ifdef __cplusplus
+extern "C" {
+#endif
+
+typedef struct tagIggSelectionRequest
+{
+   int     Type;
+   IggBool Selected;
+   int     RangeDirection;
+   int64_t RangeFirstItem;
+   int64_t RangeLastItem;
+} IggSelectionRequest;
+
+extern IggMultiSelectIO iggBeginMultiSelect(int flags, int selectionSize, int itemsCount);
+extern IggMultiSelectIO iggEndMultiSelect(void);
+extern void iggSetNextItemSelectionUserData(int64_t selectionUserData);
+extern IggBool iggIsItemToggledSelection(void);
+
+extern int iggMultiSelectIOGetRequestsCount(IggMultiSelectIO handle);
+extern void iggMultiSelectIOGetRequest(IggMultiSelectIO handle, int index, IggSelectionRequest *out);
+extern int64_t iggMultiSelectIOGetRangeSrcItem(IggMultiSelectIO handle);
+extern int64_t iggMultiSelectIOGetNavIdItem(IggMultiSelectIO handle);
+extern IggBool iggMultiSelectIOGetNavIdSelected(IggMultiSelectIO handle);
+extern void iggMultiSelectIOSetRangeSrcReset(IggMultiSelectIO handle, IggBool value);
+extern int iggMultiSelectIOGetItemsCount(IggMultiSelectIO handle);
+
+#ifdef __cplusplus
+}
+#endif
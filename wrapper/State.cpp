@@ -1,4 +1,5 @@
 #include "ConfiguredImGui.h"
+#include "imgui_internal.h"
 
 #include "State.h"
 #include "WrapperConverter.h"
@@ -98,6 +99,36 @@ IggBool iggIsKeyReleased(int key)
    return ImGui::IsKeyReleased((ImGuiKey)key);
 }
 
+IggBool iggIsKeyChordPressed(int keyChord)
+{
+   return ImGui::IsKeyChordPressed(keyChord) ? 1 : 0;
+}
+
+char const *iggGetKeyName(int key)
+{
+   return ImGui::GetKeyName((ImGuiKey)key);
+}
+
+char const *iggGetKeyChordName(int keyChord)
+{
+   return ImGui::GetKeyChordName(keyChord);
+}
+
+IggBool iggShortcut(int keyChord, int flags)
+{
+   return ImGui::Shortcut(keyChord, flags) ? 1 : 0;
+}
+
+void iggSetNextItemShortcut(int keyChord, int flags)
+{
+   ImGui::SetNextItemShortcut(keyChord, flags);
+}
+
+void iggSetItemKeyOwner(int key)
+{
+   ImGui::SetItemKeyOwner((ImGuiKey)key);
+}
+
 IggBool iggIsMouseDown(int button)
 {
    return ImGui::IsMouseDown(button);
@@ -162,3 +193,13 @@ void iggGetItemRectMax(IggVec2 *pos)
 {
    exportValue(*pos, ImGui::GetItemRectMax());
 }
+
+void iggGetItemRectSize(IggVec2 *size)
+{
+   exportValue(*size, ImGui::GetItemRectSize());
+}
+
+unsigned int iggGetItemID(void)
+{
+   return ImGui::GetItemID();
+}
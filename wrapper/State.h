@@ -30,6 +30,12 @@ extern IggBool iggIsWindowHovered(int flags);
 extern IggBool iggIsKeyDown(int key);
 extern IggBool iggIsKeyPressed(int key, IggBool repeat);
 extern IggBool iggIsKeyReleased(int key);
+extern IggBool iggIsKeyChordPressed(int keyChord);
+extern char const *iggGetKeyName(int key);
+extern char const *iggGetKeyChordName(int keyChord);
+extern IggBool iggShortcut(int keyChord, int flags);
+extern void iggSetNextItemShortcut(int keyChord, int flags);
+extern void iggSetItemKeyOwner(int key);
 extern IggBool iggIsMouseDown(int button);
 extern IggBool iggIsAnyMouseDown();
 extern IggBool iggIsMouseClicked(int button, IggBool repeat);
@@ -44,6 +50,8 @@ extern void iggSetMouseCursor(int cursor);
 
 extern void iggGetItemRectMax(IggVec2 *pos);
 extern void iggGetItemRectMin(IggVec2 *pos);
+extern void iggGetItemRectSize(IggVec2 *size);
+extern unsigned int iggGetItemID(void);
 
 #ifdef __cplusplus
 }
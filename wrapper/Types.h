@@ -15,6 +15,7 @@ typedef void *IggContext;
 typedef void *IggDrawCmd;
 typedef void *IggDrawData;
 typedef void *IggDrawList;
+typedef void *IggDrawListSplitter;
 typedef void *IggFontAtlas;
 typedef void *IggFontConfig;
 typedef void *IggFont;
@@ -23,6 +24,8 @@ typedef void *IggGlyphRanges;
 typedef void *IggGuiStyle;
 typedef void *IggInputTextCallbackData;
 typedef void *IggIO;
+typedef void *IggListClipper;
+typedef void *IggMultiSelectIO;
 typedef unsigned int IggPackedColor;
 typedef void *IggPayload;
 typedef void *IggPlatformIO;
@@ -186,6 +186,21 @@ IggBool iggInputInt(char const *label, int *value, int step, int step_fast, int
    return ImGui::InputInt(label, value, step, step_fast, flags) ? 1 : 0;
 }
 
+IggBool iggDragScalarN(char const *label, int dataType, void *data, int components, float speed, void const *min, void const *max, char const *format, int flags)
+{
+   return ImGui::DragScalarN(label, dataType, data, components, speed, min, max, format, flags) ? 1 : 0;
+}
+
+IggBool iggSliderScalarN(char const *label, int dataType, void *data, int components, void const *min, void const *max, char const *format, int flags)
+{
+   return ImGui::SliderScalarN(label, dataType, data, components, min, max, format, flags) ? 1 : 0;
+}
+
+IggBool iggInputScalarN(char const *label, int dataType, void *data, int components, void const *step, void const *stepFast, char const *format, int flags)
+{
+   return ImGui::InputScalarN(label, dataType, data, components, step, stepFast, format, flags) ? 1 : 0;
+}
+
 IggBool iggCollapsingHeader(const char *label, int flags)
 {
    return ImGui::CollapsingHeader(label, flags) ? 1 : 0;
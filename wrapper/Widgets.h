@@ -49,6 +49,10 @@ extern IggBool iggInputTextMultiline(char const *label, char *buf, unsigned int
 
 extern IggBool iggInputInt(char const *label, int *value, int step, int step_fast, int flags);
 
+extern IggBool iggDragScalarN(char const *label, int dataType, void *data, int components, float speed, void const *min, void const *max, char const *format, int flags);
+extern IggBool iggSliderScalarN(char const *label, int dataType, void *data, int components, void const *min, void const *max, char const *format, int flags);
+extern IggBool iggInputScalarN(char const *label, int dataType, void *data, int components, void const *step, void const *stepFast, char const *format, int flags);
+
 extern IggBool iggTreeNode(char const *label, int flags);
 extern void iggTreePop(void);
 extern void iggSetNextItemOpen(IggBool open, int cond);